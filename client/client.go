@@ -13,15 +13,17 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
-type ChatMessageHandler func(from, message string)
+type ChatMessageHandler func(message *esive_grpc.ChatMessage)
 type UpdateRenderableHandler func(id, tick int64, renderable *esive_grpc.Renderable)
 type DeleteRenderableHandler func(id, tick int64)
 
 type ClientOpts struct {
-	addr string
-	name string
+	addr     string
+	name     string
+	password string
 }
 
 type Client struct {
@@ -34,6 +36,7 @@ type Client struct {
 	esiveClient esive_grpc.EsiveClient
 
 	PlayerID int64
+	DayCycle tick.DayCycle
 
 	chatMessageHandlersMtx sync.Mutex
 	chatMessageHandlers    []ChatMessageHandler
@@ -43,18 +46,43 @@ type Client struct {
 
 	deleteRenderableHandlersMtx sync.Mutex
 	deleteRenderableHandlers    []DeleteRenderableHandler
+
+	// renderables caches the last known state of everything in sight, so deltas from the server can be applied.
+	renderablesMtx sync.Mutex
+	renderables    map[int64]*esive_grpc.Renderable
+
+	// stream is the session with the server, which carries the commands of the player and the events for them.
+	// Commands are dropped while there is none.
+	streamMtx     sync.Mutex
+	stream        esive_grpc.Esive_SessionClient
+	streamCancel  context.CancelFunc
+	nextCommandID int64
+	// pending has when every command without an ack was sent, to track the latency.
+	pending map[int64]time.Time
+
+	// reconnecting is set while the client tries to get back a lost connection, and closed once Disconnect is called,
+	// so it doesn't try at all.
+	reconnectMtx sync.Mutex
+	reconnecting bool
+	closed       bool
+
+	// session is the token given by the server on login. It's sent with every request.
+	sessionMtx sync.Mutex
+	session    string
 }
 
-func NewClient(addr, name string) *Client {
+func NewClient(addr, name, password string) *Client {
 	return &Client{
 		opts: ClientOpts{
-			addr: addr,
-			name: name,
+			addr:     addr,
+			name:     name,
+			password: password,
 		},
 		latencyTracker:           newLatencyTracker(10),
 		chatMessageHandlers:      make([]ChatMessageHandler, 0),
 		updateRenderableHandlers: make([]UpdateRenderableHandler, 0),
 		deleteRenderableHandlers: make([]DeleteRenderableHandler, 0),
+		renderables:              make(map[int64]*esive_grpc.Renderable),
 	}
 }
 
@@ -93,7 +121,14 @@ func (c *Client) Connect() error {
 			// Set client tick in the request header
 			func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 				if c.Tick != nil {
-					ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("tick", strconv.FormatInt(c.Tick.Current(), 10)))
+					ctx = metadata.AppendToOutgoingContext(ctx, "tick", strconv.FormatInt(c.Tick.Current(), 10))
+				}
+				return invoker(ctx, method, req, reply, cc, opts...)
+			},
+			// Set the session in the request header
+			func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+				if session := c.sessionToken(); session != "" {
+					ctx = metadata.AppendToOutgoingContext(ctx, "session", session)
 				}
 				return invoker(ctx, method, req, reply, cc, opts...)
 			},
@@ -104,11 +139,17 @@ func (c *Client) Connect() error {
 				err := invoker(ctx, method, req, reply, cc, opts...)
 				receivedTick, found := c.getTickFromMD(md)
 				if found && c.Tick != nil {
-					c.Tick.AdjustOnce(c.desiredClientTick(receivedTick, c.Tick.Delay))
+					c.Tick.AdjustOnce(c.desiredClientTick(receivedTick, c.Tick.Delay()))
 				}
 				return err
 			},
 		),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			if session := c.sessionToken(); session != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "session", session)
+			}
+			return streamer(ctx, desc, cc, method, opts...)
+		}),
 	)
 	if err != nil {
 		return err
@@ -118,59 +159,211 @@ func (c *Client) Connect() error {
 	c.esiveClient = esive_grpc.NewEsiveClient(conn)
 
 	var md metadata.MD
-	res, err := c.esiveClient.Join(context.Background(), &esive_grpc.JoinReq{
-		Name: c.opts.name,
-	}, grpc.Header(&md))
+	res, err := c.login(grpc.Header(&md))
 	if err != nil {
 		return err
 	}
 	c.PlayerID = res.PlayerId
+	c.DayCycle = tick.DayCycle{Length: res.DayLength}
 	c.initTickFromMD(md, res.TickMilliseconds)
 
-	visStream, err := c.esiveClient.TickUpdates(context.Background(), &esive_grpc.TickUpdatesReq{})
+	return c.openSession()
+}
+
+// login logs in with a new session and joins the game.
+func (c *Client) login(opts ...grpc.CallOption) (*esive_grpc.JoinRes, error) {
+	login, err := c.esiveClient.Login(context.Background(), &esive_grpc.LoginReq{
+		Name:     c.opts.name,
+		Password: c.opts.password,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.sessionMtx.Lock()
+	c.session = login.Token
+	c.sessionMtx.Unlock()
+	return c.esiveClient.Join(context.Background(), &esive_grpc.JoinReq{}, opts...)
+}
+
+func (c *Client) sessionToken() string {
+	c.sessionMtx.Lock()
+	defer c.sessionMtx.Unlock()
+	return c.session
+}
+
+func (c *Client) handleChatMessage(message *esive_grpc.ChatMessage) {
+	c.chatMessageHandlersMtx.Lock()
+	defer c.chatMessageHandlersMtx.Unlock()
+	for _, h := range c.chatMessageHandlers {
+		h(message)
+	}
+}
+
+// reconnect gets back a lost connection. It resumes the session while the server keeps it, and logs in again
+// otherwise. It keeps trying until it succeeds or the client disconnects.
+func (c *Client) reconnect() {
+	c.reconnectMtx.Lock()
+	if c.reconnecting || c.closed {
+		c.reconnectMtx.Unlock()
+		return
+	}
+	c.reconnecting = true
+	c.reconnectMtx.Unlock()
+	defer func() {
+		c.reconnectMtx.Lock()
+		c.reconnecting = false
+		c.reconnectMtx.Unlock()
+	}()
+
+	c.closeSession()
+	c.handleChatMessage(&esive_grpc.ChatMessage{Kind: esive_grpc.ChatMessage_ERROR, Text: "Connection lost. Reconnecting..."})
+
+	backoff := 500 * time.Millisecond
+	for {
+		c.reconnectMtx.Lock()
+		closed := c.closed
+		c.reconnectMtx.Unlock()
+		if closed {
+			return
+		}
+
+		res, err := c.esiveClient.Resume(context.Background(), &esive_grpc.ResumeReq{Token: c.sessionToken()})
+		if err != nil {
+			res, err = c.login()
+		}
+		if err == nil {
+			c.PlayerID = res.PlayerId
+			err = c.openSession()
+		}
+		if err == nil {
+			c.handleChatMessage(&esive_grpc.ChatMessage{Kind: esive_grpc.ChatMessage_SYSTEM, Text: "Reconnected."})
+			return
+		}
+
+		fmt.Println(err.Error())
+		time.Sleep(backoff)
+		if backoff < 10*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+// openSession opens the stream that carries the commands of the player and the events for them. The server starts
+// it with the full state.
+func (c *Client) openSession() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.esiveClient.Session(ctx)
 	if err != nil {
-		panic(err)
+		cancel()
+		return err
 	}
+	c.streamMtx.Lock()
+	c.stream = stream
+	c.streamCancel = cancel
+	c.pending = map[int64]time.Time{}
+	c.streamMtx.Unlock()
 
 	go func() {
+		// Set from asking for the full state until it arrives, so the updates in between are ignored.
+		resyncing := false
 		for {
-			e, err := visStream.Recv()
+			e, err := stream.Recv()
 			if err != nil {
-				fmt.Println(err.Error())
+				if ctx.Err() == nil {
+					fmt.Println(err.Error())
+					go c.reconnect()
+				}
 				return
 			}
-			for _, visibilityUpdate := range e.VisibilityUpdates {
-				switch visibilityUpdate.Action {
-				case esive_grpc.VisibilityUpdate_ADD:
-					c.updateRenderable(visibilityUpdate.Tick, visibilityUpdate.Renderable)
-				case esive_grpc.VisibilityUpdate_REMOVE:
-					c.deleteRenderable(visibilityUpdate.Tick, visibilityUpdate.Renderable.Id)
+			switch event := e.Event.(type) {
+			case *esive_grpc.SessionRes_TickUpdates:
+				if event.TickUpdates.Resync {
+					resyncing = false
+				}
+				if !resyncing && !c.handleTickUpdates(event.TickUpdates) {
+					resyncing = true
+					c.resync()
 				}
+			case *esive_grpc.SessionRes_Chat:
+				c.handleChatMessage(event.Chat)
+			case *esive_grpc.SessionRes_Ack:
+				c.handleAck(event.Ack)
+			case *esive_grpc.SessionRes_TickSync:
+				if delay := time.Duration(event.TickSync.TickMilliseconds) * time.Millisecond; delay != c.Tick.Delay() {
+					c.Tick.SetDelay(delay)
+				}
+				c.Tick.AdjustOnce(c.desiredClientTick(event.TickSync.Tick, c.Tick.Delay()))
 			}
 		}
 	}()
+	return nil
+}
 
-	chatStream, err := c.esiveClient.ChatUpdates(context.Background(), &esive_grpc.ChatUpdatesReq{})
-	if err != nil {
-		panic(err)
+func (c *Client) closeSession() {
+	c.streamMtx.Lock()
+	defer c.streamMtx.Unlock()
+	if c.streamCancel != nil {
+		c.streamCancel()
 	}
+	c.stream = nil
+}
 
-	go func() {
-		for {
-			e, err := chatStream.Recv()
-			if err != nil {
-				fmt.Println(err.Error())
-				return
-			}
-			c.chatMessageHandlersMtx.Lock()
-			for _, h := range c.chatMessageHandlers {
-				h(e.Message.From, e.Message.Text)
+// handleTickUpdates applies the visibility updates of a tick. It returns false if one can't be applied, and the full
+// state is needed.
+func (c *Client) handleTickUpdates(e *esive_grpc.TickUpdatesRes) bool {
+	if e.Resync {
+		c.resetRenderables(e.VisibilityUpdates)
+	}
+	for _, visibilityUpdate := range e.VisibilityUpdates {
+		switch visibilityUpdate.Action {
+		case esive_grpc.VisibilityUpdate_ADD:
+			c.updateRenderable(visibilityUpdate.Tick, visibilityUpdate.Renderable)
+		case esive_grpc.VisibilityUpdate_UPDATE:
+			if !c.applyDelta(visibilityUpdate.Tick, visibilityUpdate.Delta) {
+				return false
 			}
-			c.chatMessageHandlersMtx.Unlock()
+		case esive_grpc.VisibilityUpdate_REMOVE:
+			c.deleteRenderable(visibilityUpdate.Tick, visibilityUpdate.Renderable.Id)
 		}
-	}()
+	}
+	return true
+}
 
-	return nil
+// handleAck tracks the latency of a command, and adjusts the tick to the one of the server.
+func (c *Client) handleAck(ack *esive_grpc.SessionAck) {
+	c.streamMtx.Lock()
+	sent, found := c.pending[ack.Id]
+	delete(c.pending, ack.Id)
+	c.streamMtx.Unlock()
+	if found {
+		c.latencyTracker.addLatency(time.Since(sent))
+	}
+	if ack.Error != "" {
+		fmt.Println(ack.Error)
+	}
+	c.Tick.AdjustOnce(c.desiredClientTick(ack.Tick, c.Tick.Delay()))
+}
+
+// sendCommand sends a command on the session, for the current tick of the client.
+func (c *Client) sendCommand(req *esive_grpc.SessionReq) {
+	c.streamMtx.Lock()
+	defer c.streamMtx.Unlock()
+	if c.stream == nil {
+		return
+	}
+	c.nextCommandID++
+	req.Id = c.nextCommandID
+	req.Tick = c.Tick.Current()
+	c.pending[req.Id] = time.Now()
+	if err := c.stream.Send(req); err != nil {
+		// Receiving fails too, and reconnects.
+		delete(c.pending, req.Id)
+	}
+}
+
+// resync asks for the full state, when the client can't apply an update.
+func (c *Client) resync() {
+	c.sendCommand(&esive_grpc.SessionReq{Command: &esive_grpc.SessionReq_Resync{Resync: &esive_grpc.ResyncReq{}}})
 }
 
 func (c *Client) getTickFromMD(md metadata.MD) (int64, bool) {
@@ -197,6 +390,9 @@ func (c *Client) initTickFromMD(md metadata.MD, durationMs int32) {
 }
 
 func (c *Client) Disconnect() error {
+	c.reconnectMtx.Lock()
+	c.closed = true
+	c.reconnectMtx.Unlock()
 	if err := c.grpcConn.Close(); err != nil {
 		return err
 	}
@@ -204,6 +400,10 @@ func (c *Client) Disconnect() error {
 }
 
 func (c *Client) updateRenderable(tick int64, renderable *esive_grpc.Renderable) {
+	c.renderablesMtx.Lock()
+	c.renderables[renderable.Id] = renderable
+	c.renderablesMtx.Unlock()
+
 	c.updateRenderableHandlersMtx.Lock()
 	defer c.updateRenderableHandlersMtx.Unlock()
 
@@ -212,7 +412,60 @@ func (c *Client) updateRenderable(tick int64, renderable *esive_grpc.Renderable)
 	}
 }
 
+// applyDelta updates a cached renderable with the fields in the delta. It returns false if the renderable isn't
+// cached, which means the client is out of sync.
+func (c *Client) applyDelta(tick int64, delta *esive_grpc.RenderableDelta) bool {
+	c.renderablesMtx.Lock()
+	cached, found := c.renderables[delta.Id]
+	c.renderablesMtx.Unlock()
+	if !found {
+		return false
+	}
+
+	// Handlers may keep the renderables they receive, so they are never modified.
+	renderable := proto.Clone(cached).(*esive_grpc.Renderable)
+	if delta.Position != nil {
+		renderable.Position = delta.Position
+	}
+	if delta.Velocity != nil {
+		renderable.Velocity = delta.Velocity
+	}
+	if delta.Appearance != nil {
+		renderable.Char = delta.Appearance.Char
+		renderable.Color = delta.Appearance.Color
+		renderable.Light = delta.Appearance.Light
+	}
+	c.updateRenderable(tick, renderable)
+	return true
+}
+
+// resetRenderables forgets the renderables that aren't part of a full state update.
+func (c *Client) resetRenderables(updates []*esive_grpc.VisibilityUpdate) {
+	keep := map[int64]struct{}{}
+	for _, update := range updates {
+		if update.Renderable != nil {
+			keep[update.Renderable.Id] = struct{}{}
+		}
+	}
+	c.renderablesMtx.Lock()
+	forget := []int64{}
+	for id := range c.renderables {
+		if _, found := keep[id]; !found {
+			forget = append(forget, id)
+		}
+	}
+	c.renderablesMtx.Unlock()
+
+	for _, id := range forget {
+		c.deleteRenderable(0, id)
+	}
+}
+
 func (c *Client) deleteRenderable(tick int64, id int64) {
+	c.renderablesMtx.Lock()
+	delete(c.renderables, id)
+	c.renderablesMtx.Unlock()
+
 	c.deleteRenderableHandlersMtx.Lock()
 	defer c.deleteRenderableHandlersMtx.Unlock()
 
@@ -242,18 +495,68 @@ func (c *Client) desiredClientTick(serverTick int64, tickDuration time.Duration)
 }
 
 func (c *Client) SetVelocity(x, y int) {
-	c.esiveClient.SetVelocity(context.Background(), &esive_grpc.Velocity{
+	c.sendCommand(&esive_grpc.SessionReq{Command: &esive_grpc.SessionReq_Velocity{Velocity: &esive_grpc.Velocity{
 		X: int64(x),
 		Y: int64(y),
-	})
+	}}})
 }
 
 func (c *Client) SendChatMessage(message string) {
-	c.esiveClient.Say(context.Background(), &esive_grpc.SayReq{
+	c.sendCommand(&esive_grpc.SessionReq{Command: &esive_grpc.SessionReq_Say{Say: &esive_grpc.SayReq{
 		Text: message,
+	}}})
+}
+
+// CompleteCommand returns the ways to complete a partial command line.
+func (c *Client) CompleteCommand(text string) ([]string, error) {
+	res, err := c.esiveClient.CompleteCommand(context.Background(), &esive_grpc.CompleteCommandReq{
+		Text: text,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Completions, nil
+}
+
+// ChatHistory returns up to `limit` messages of a channel with an id lower than `before` (zero means now), from the
+// oldest to the newest.
+func (c *Client) ChatHistory(channel string, before int64, limit int32) ([]*esive_grpc.ChatMessage, error) {
+	res, err := c.esiveClient.ChatHistory(context.Background(), &esive_grpc.ChatHistoryReq{
+		Channel: channel,
+		Before:  before,
+		Limit:   limit,
 	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Messages, nil
+}
+
+// ListMail returns the mail of the player, without their text, from the oldest to the newest.
+func (c *Client) ListMail() ([]*esive_grpc.Mail, error) {
+	res, err := c.esiveClient.ListMail(context.Background(), &esive_grpc.ListMailReq{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Mails, nil
+}
+
+// ReadMail returns a mail with its text, and marks it as read.
+func (c *Client) ReadMail(id int64) (*esive_grpc.Mail, error) {
+	res, err := c.esiveClient.ReadMail(context.Background(), &esive_grpc.ReadMailReq{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return res.Mail, nil
+}
+
+func (c *Client) DeleteMail(id int64) error {
+	_, err := c.esiveClient.DeleteMail(context.Background(), &esive_grpc.DeleteMailReq{Id: id})
+	return err
 }
 
 func (c *Client) Read(x, y int64) {
-	c.esiveClient.Read(context.Background(), &esive_grpc.ReadReq{Position: &esive_grpc.Position{X: x, Y: y}})
+	c.sendCommand(&esive_grpc.SessionReq{Command: &esive_grpc.SessionReq_Read{Read: &esive_grpc.ReadReq{
+		Position: &esive_grpc.Position{X: x, Y: y},
+	}}})
 }
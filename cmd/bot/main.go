@@ -35,15 +35,19 @@ func main() {
 }
 
 func runBot(n int) {
-	name := fmt.Sprintf("Bot %d", n)
+	name := fmt.Sprintf("bot-%d", n)
 
 	var t *tick.Tick
+	session := ""
 	conn, err := grpc.Dial("localhost:9000",
 		grpc.WithInsecure(),
 		grpc.WithChainUnaryInterceptor(
 			func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 				if t != nil {
-					ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("tick", strconv.FormatInt(t.Current(), 10)))
+					ctx = metadata.AppendToOutgoingContext(ctx, "tick", strconv.FormatInt(t.Current(), 10))
+				}
+				if session != "" {
+					ctx = metadata.AppendToOutgoingContext(ctx, "session", session)
 				}
 				return invoker(ctx, method, req, reply, cc, opts...)
 			}),
@@ -54,10 +58,18 @@ func runBot(n int) {
 
 	client := esive_grpc.NewEsiveClient(conn)
 
+	loginRes, err := client.Login(context.Background(), &esive_grpc.LoginReq{
+		Name:     name,
+		Password: name,
+	})
+	if err != nil {
+		panic(err)
+	}
+	session = loginRes.Token
+	streamCtx := metadata.AppendToOutgoingContext(context.Background(), "session", session)
+
 	var md metadata.MD
-	joinRes, err := client.Join(context.Background(), &esive_grpc.JoinReq{
-		Name: name,
-	}, grpc.Header(&md))
+	joinRes, err := client.Join(context.Background(), &esive_grpc.JoinReq{}, grpc.Header(&md))
 	if err != nil {
 		panic(err)
 	}
@@ -65,11 +77,11 @@ func runBot(n int) {
 	t = tick.NewTick(serverTick+3, time.Duration(joinRes.TickMilliseconds)*time.Millisecond)
 	go t.Start()
 
-	visRes, err := client.TickUpdates(context.Background(), &esive_grpc.TickUpdatesReq{})
+	visRes, err := client.TickUpdates(streamCtx, &esive_grpc.TickUpdatesReq{})
 	if err != nil {
 		panic(err)
 	}
-	chatRes, err := client.ChatUpdates(context.Background(), &esive_grpc.ChatUpdatesReq{})
+	chatRes, err := client.ChatUpdates(streamCtx, &esive_grpc.ChatUpdatesReq{})
 	if err != nil {
 		panic(err)
 	}
@@ -14,6 +14,7 @@ var (
 	defaultAddr = "localhost:9000"
 	addr        = flag.String("addr", defaultAddr, "Server address")
 	name        = flag.String("name", "", "Your name. Required.")
+	password    = flag.String("password", "", "Your password. The account is created with it the first time you log in.")
 )
 
 func main() {
@@ -23,7 +24,7 @@ func main() {
 		panic("the `name` flag is required.")
 	}
 
-	c := client.NewClient(*addr, *name)
+	c := client.NewClient(*addr, *name, *password)
 	if err := c.Connect(); err != nil {
 		panic(err)
 	}
@@ -5,10 +5,12 @@ import (
 	"image/color"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/blizzy78/ebitenui"
 	"github.com/blizzy78/ebitenui/widget"
 	"github.com/code-cell/esive/client"
+	esive_grpc "github.com/code-cell/esive/grpc"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/examples/resources/fonts"
 	"golang.org/x/image/font"
@@ -62,11 +64,57 @@ func NewGame(c *client.Client) *Game {
 	c.AddChatHandler(menu.HandleChatMessage)
 	prediction := NewPrediction()
 
+	menu.textInput.CompleteEvent.AddHandler(func(args interface{}) {
+		eventArgs := args.(*TextInputCompleteEventArgs)
+		completions, err := c.CompleteCommand(eventArgs.InputText)
+		if err != nil || len(completions) == 0 {
+			return
+		}
+		eventArgs.TextInput.InputText = commonPrefix(completions)
+		if len(completions) > 1 {
+			menu.HandleChatMessage(&esive_grpc.ChatMessage{Kind: esive_grpc.ChatMessage_SYSTEM, Text: strings.Join(completions, "  ")})
+		}
+	})
+
+	// The oldest message shown of every channel, so `/history` goes further back every time.
+	historyBefore := map[string]int64{}
 	menu.textInput.SendEvent.AddHandler(func(args interface{}) {
 		eventArgs := args.(*TextInputSendEventArgs)
+		if strings.HasPrefix(eventArgs.InputText, "/history") {
+			channel := strings.TrimSpace(strings.TrimPrefix(eventArgs.InputText, "/history"))
+			if channel == "" {
+				channel = "local"
+			}
+			messages, err := c.ChatHistory(channel, historyBefore[channel], 10)
+			if err != nil {
+				menu.HandleChatMessage(&esive_grpc.ChatMessage{Kind: esive_grpc.ChatMessage_ERROR, Text: err.Error()})
+				return
+			}
+			if len(messages) > 0 {
+				historyBefore[channel] = messages[0].Id
+			}
+			menu.ShowHistory(channel, messages)
+			return
+		}
+		if handled := handleMailCommand(c, menu, eventArgs.InputText); handled {
+			return
+		}
 		c.SendChatMessage(eventArgs.InputText)
 	})
 
+	if mails, err := c.ListMail(); err == nil {
+		unread := []*esive_grpc.Mail{}
+		for _, mail := range mails {
+			if !mail.Read {
+				unread = append(unread, mail)
+			}
+		}
+		if len(unread) > 0 {
+			menu.HandleChatMessage(&esive_grpc.ChatMessage{Kind: esive_grpc.ChatMessage_SYSTEM, Text: fmt.Sprintf("You have %d unread mails.", len(unread))})
+			menu.ShowMail(unread)
+		}
+	}
+
 	worldView := NewWorldView(31, 31, c, prediction, 15)
 	worldView.Focus(true)
 
@@ -164,3 +212,70 @@ func hexToColor(h string) color.Color {
 		A: 255,
 	}
 }
+
+func commonPrefix(values []string) string {
+	prefix := values[0]
+	for _, v := range values[1:] {
+		for !strings.HasPrefix(v, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// handleMailCommand runs the mail commands, which are handled by the client. It returns false if the line isn't one.
+func handleMailCommand(c *client.Client, menu *Menu, line string) bool {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return false
+	}
+	showError := func(err error) {
+		menu.HandleChatMessage(&esive_grpc.ChatMessage{Kind: esive_grpc.ChatMessage_ERROR, Text: err.Error()})
+	}
+	mailID := func() (int64, bool) {
+		if len(words) != 2 {
+			showError(fmt.Errorf("Usage: %v ID", words[0]))
+			return 0, false
+		}
+		id, err := strconv.ParseInt(strings.TrimPrefix(words[1], "#"), 10, 64)
+		if err != nil {
+			showError(fmt.Errorf("Usage: %v ID", words[0]))
+			return 0, false
+		}
+		return id, true
+	}
+
+	switch words[0] {
+	case "/inbox":
+		mails, err := c.ListMail()
+		if err != nil {
+			showError(err)
+			return true
+		}
+		menu.ShowMail(mails)
+	case "/readmail":
+		id, ok := mailID()
+		if !ok {
+			return true
+		}
+		mail, err := c.ReadMail(id)
+		if err != nil {
+			showError(err)
+			return true
+		}
+		menu.ShowMailText(mail)
+	case "/delmail":
+		id, ok := mailID()
+		if !ok {
+			return true
+		}
+		if err := c.DeleteMail(id); err != nil {
+			showError(err)
+			return true
+		}
+		menu.HandleChatMessage(&esive_grpc.ChatMessage{Kind: esive_grpc.ChatMessage_SYSTEM, Text: fmt.Sprintf("Mail #%d deleted.", id)})
+	default:
+		return false
+	}
+	return true
+}
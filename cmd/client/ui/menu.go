@@ -3,9 +3,11 @@ package ui
 import (
 	"fmt"
 	"image/color"
+	"time"
 
 	"github.com/blizzy78/ebitenui/image"
 	"github.com/blizzy78/ebitenui/widget"
+	esive_grpc "github.com/code-cell/esive/grpc"
 	"golang.org/x/image/font"
 )
 
@@ -73,13 +75,73 @@ func NewMenu(ff font.Face) *Menu {
 	menu.chatText.Label += "\n"
 	menu.chatText.Label += "Use the arrows in your keyboard to move around.\n"
 	menu.chatText.Label += "Type '/help' in the chat to see the list of commands.\n"
+	menu.chatText.Label += "Type '/history CHANNEL' to see older messages. Repeat it to go further back.\n"
+	menu.chatText.Label += "Type '/inbox' to see your mail, '/readmail ID' to read one and '/delmail ID' to delete it.\n"
 	menu.chatText.Label += "Press 'esc' to close the game.\n"
 	menu.chatText.Label += "\n"
 
 	return menu
 }
 
-func (m *Menu) HandleChatMessage(from, message string) {
+func (m *Menu) HandleChatMessage(message *esive_grpc.ChatMessage) {
 	m.vslider.Current = m.vslider.Max
-	m.chatText.Label += fmt.Sprintf("%v: %v\n", from, message)
+	if message.Timestamp != 0 {
+		m.chatText.Label += time.Unix(0, message.Timestamp*int64(time.Millisecond)).Format("15:04 ")
+	}
+	switch message.Kind {
+	case esive_grpc.ChatMessage_SYSTEM:
+		m.chatText.Label += fmt.Sprintf("> %v\n", message.Text)
+	case esive_grpc.ChatMessage_ERROR:
+		m.chatText.Label += fmt.Sprintf("! %v\n", message.Text)
+	case esive_grpc.ChatMessage_EMOTE:
+		m.chatText.Label += fmt.Sprintf("* %v %v\n", message.From, message.Text)
+	case esive_grpc.ChatMessage_WHISPER:
+		m.chatText.Label += fmt.Sprintf("[%v -> %v] %v\n", message.From, message.To, message.Text)
+	default:
+		if message.Channel == "" || message.Channel == "local" {
+			m.chatText.Label += fmt.Sprintf("%v: %v\n", message.From, message.Text)
+		} else {
+			m.chatText.Label += fmt.Sprintf("[%v] %v: %v\n", message.Channel, message.From, message.Text)
+		}
+	}
+}
+
+// ShowHistory prints past messages of a channel.
+func (m *Menu) ShowHistory(channel string, messages []*esive_grpc.ChatMessage) {
+	if len(messages) == 0 {
+		m.chatText.Label += fmt.Sprintf("-- No older messages in %v --\n", channel)
+		return
+	}
+	m.chatText.Label += fmt.Sprintf("-- History of %v --\n", channel)
+	for _, message := range messages {
+		m.HandleChatMessage(message)
+	}
+	m.chatText.Label += "--\n"
+}
+
+// ShowMail lists the mail of the player, without their text.
+func (m *Menu) ShowMail(mails []*esive_grpc.Mail) {
+	if len(mails) == 0 {
+		m.chatText.Label += "-- Your mailbox is empty --\n"
+		return
+	}
+	m.chatText.Label += "-- Mailbox --\n"
+	for _, mail := range mails {
+		unread := ""
+		if !mail.Read {
+			unread = " (unread)"
+		}
+		m.chatText.Label += fmt.Sprintf("#%d %v from %v%v\n", mail.Id, formatTimestamp(mail.Timestamp), mail.From, unread)
+	}
+	m.chatText.Label += "--\n"
+}
+
+// ShowMailText prints a mail with its text.
+func (m *Menu) ShowMailText(mail *esive_grpc.Mail) {
+	m.vslider.Current = m.vslider.Max
+	m.chatText.Label += fmt.Sprintf("-- Mail #%d from %v, %v --\n%v\n--\n", mail.Id, mail.From, formatTimestamp(mail.Timestamp), mail.Text)
+}
+
+func formatTimestamp(timestamp int64) string {
+	return time.Unix(0, timestamp*int64(time.Millisecond)).Format("Jan 2 15:04")
 }
@@ -11,19 +11,28 @@ type TextInputSendEventArgs struct {
 	InputText string
 }
 
+type TextInputCompleteEventArgs struct {
+	TextInput *TextInput
+	InputText string
+}
+
 type TextInput struct {
 	*widget.TextInput
 	focused bool
 
 	wasEnterPressed bool
 	SendEvent       *event.Event
+
+	wasTabPressed bool
+	CompleteEvent *event.Event
 }
 
 func NewTextInput(opts ...widget.TextInputOpt) *TextInput {
 	return &TextInput{
 		TextInput: widget.NewTextInput(opts...),
 
-		SendEvent: &event.Event{},
+		SendEvent:     &event.Event{},
+		CompleteEvent: &event.Event{},
 	}
 }
 
@@ -42,6 +51,19 @@ func (t *TextInput) Render(screen *ebiten.Image, def widget.DeferredRenderFunc)
 	} else {
 		t.wasEnterPressed = false
 	}
+
+	if t.focused && ebiten.IsKeyPressed(ebiten.KeyTab) {
+		if t.wasTabPressed {
+			return
+		}
+		t.wasTabPressed = true
+		t.CompleteEvent.Fire(&TextInputCompleteEventArgs{
+			TextInput: t,
+			InputText: t.InputText,
+		})
+	} else {
+		t.wasTabPressed = false
+	}
 }
 
 func (t *TextInput) Focus(focused bool) {
@@ -10,6 +10,7 @@ import (
 	"github.com/code-cell/esive/client"
 	esive_grpc "github.com/code-cell/esive/grpc"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"github.com/hajimehoshi/ebiten/v2/examples/resources/fonts"
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"golang.org/x/image/font"
@@ -94,6 +95,10 @@ func (g *WorldView) draw(screen *ebiten.Image) {
 	g.mtx.Lock()
 	defer g.mtx.Unlock()
 
+	if g.client.DayCycle.IsNight(g.client.Tick.Current()) {
+		g.drawNight(screen, cellWidth, cellHeight)
+	}
+
 	for id, r := range g.renderables {
 		x := r.Position.X
 		y := r.Position.Y
@@ -101,6 +106,10 @@ func (g *WorldView) draw(screen *ebiten.Image) {
 			x = g.playerX
 			y = g.playerY
 		}
+		if abs(x-g.playerX) > g.visibility || abs(y-g.playerY) > g.visibility {
+			// The server can send entities further than what fits in the view.
+			continue
+		}
 
 		col := color.RGBA{
 			R: uint8(r.Color >> 24),
@@ -118,6 +127,41 @@ func (g *WorldView) draw(screen *ebiten.Image) {
 	}
 }
 
+// drawNight darkens the view and tints the tiles lit by light sources with their color. It has to be called with the
+// mutex locked.
+func (g *WorldView) drawNight(screen *ebiten.Image, cellWidth, cellHeight float64) {
+	r := g.widget.Rect
+	ebitenutil.DrawRect(screen, float64(r.Min.X), float64(r.Min.Y), float64(r.Dx()), float64(r.Dy()), color.RGBA{R: 8, G: 8, B: 24, A: 200})
+
+	for _, renderable := range g.renderables {
+		if renderable.Light == nil {
+			continue
+		}
+		lightColor := color.RGBA{
+			R: uint8(renderable.Light.Color >> 24),
+			G: uint8(renderable.Light.Color >> 16),
+			B: uint8(renderable.Light.Color >> 8),
+			A: 40,
+		}
+		radius := int64(renderable.Light.Radius)
+		for x := renderable.Position.X - radius; x <= renderable.Position.X+radius; x++ {
+			for y := renderable.Position.Y - radius; y <= renderable.Position.Y+radius; y++ {
+				dx, dy := x-renderable.Position.X, y-renderable.Position.Y
+				if float32(dx*dx+dy*dy) > renderable.Light.Radius*renderable.Light.Radius {
+					continue
+				}
+				if abs(x-g.playerX) > g.visibility || abs(y-g.playerY) > g.visibility {
+					continue
+				}
+				ebitenutil.DrawRect(screen,
+					float64((x-g.playerX)+g.visibility)*cellWidth,
+					float64((y-g.playerY)+g.visibility)*cellHeight,
+					cellWidth, cellHeight, lightColor)
+			}
+		}
+	}
+}
+
 func (g *WorldView) GetWidget() *widget.Widget {
 	return g.widget
 }
@@ -133,3 +177,10 @@ func (g *WorldView) SetLocation(rect image.Rectangle) {
 func (g *WorldView) Focus(focused bool) {
 	g.focused = focused
 }
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
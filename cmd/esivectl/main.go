@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	esive_grpc "github.com/code-cell/esive/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	addr    = flag.String("addr", "localhost:9000", "Server address")
+	token   = flag.String("token", os.Getenv("ESIVE_ADMIN_TOKEN"), "Admin token of the server. Defaults to ESIVE_ADMIN_TOKEN")
+	timeout = flag.Duration("timeout", 5*time.Second, "How long to wait for the server")
+)
+
+type command struct {
+	usage  string
+	help   string
+	action func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error
+}
+
+var commands = map[string]command{
+	"players": {
+		usage: "players",
+		help:  "Lists the players in the world",
+		action: func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error {
+			res, err := client.ListPlayers(ctx, &esive_grpc.ListPlayersReq{})
+			if err != nil {
+				return err
+			}
+			for _, player := range res.Players {
+				status := ""
+				if !player.Connected {
+					status = " (disconnected)"
+				}
+				fmt.Printf("%d\t%v\t%v\t[%d,%d]%v\n", player.Entity, player.Name, player.Role, player.Position.GetX(), player.Position.GetY(), status)
+			}
+			return nil
+		},
+	},
+	"inspect": {
+		usage: "inspect ENTITY",
+		help:  "Shows every component of an entity",
+		action: func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error {
+			entity, err := argInt64(args, 0)
+			if err != nil {
+				return err
+			}
+			res, err := client.InspectEntity(ctx, &esive_grpc.InspectEntityReq{Entity: entity})
+			if err != nil {
+				return err
+			}
+			names := make([]string, 0, len(res.Components))
+			for name := range res.Components {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Printf("%v: %v\n", name, res.Components[name])
+			}
+			return nil
+		},
+	},
+	"tp": {
+		usage: "tp ENTITY X Y",
+		help:  "Teleports an entity",
+		action: func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error {
+			entity, err := argInt64(args, 0)
+			if err != nil {
+				return err
+			}
+			x, err := argInt64(args, 1)
+			if err != nil {
+				return err
+			}
+			y, err := argInt64(args, 2)
+			if err != nil {
+				return err
+			}
+			_, err = client.Teleport(ctx, &esive_grpc.TeleportReq{Entity: entity, Position: &esive_grpc.Position{X: x, Y: y}})
+			return err
+		},
+	},
+	"kick": {
+		usage: "kick NAME [REASON]",
+		help:  "Disconnects a player",
+		action: func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error {
+			if len(args) == 0 {
+				return errors.New("Missing arguments")
+			}
+			_, err := client.Kick(ctx, &esive_grpc.KickReq{Name: args[0], Reason: strings.Join(args[1:], " ")})
+			return err
+		},
+	},
+	"broadcast": {
+		usage: "broadcast TEXT",
+		help:  "Sends a system message to every player",
+		action: func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error {
+			if len(args) == 0 {
+				return errors.New("Missing arguments")
+			}
+			_, err := client.Broadcast(ctx, &esive_grpc.BroadcastReq{Text: strings.Join(args, " ")})
+			return err
+		},
+	},
+	"spawn": {
+		usage: "spawn PREFAB X Y",
+		help:  "Creates an entity of a prefab (wall or lamp), and prints its id",
+		action: func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error {
+			if len(args) == 0 {
+				return errors.New("Missing arguments")
+			}
+			x, err := argInt64(args, 1)
+			if err != nil {
+				return err
+			}
+			y, err := argInt64(args, 2)
+			if err != nil {
+				return err
+			}
+			res, err := client.Spawn(ctx, &esive_grpc.SpawnReq{Prefab: args[0], Position: &esive_grpc.Position{X: x, Y: y}})
+			if err != nil {
+				return err
+			}
+			fmt.Println(res.Entity)
+			return nil
+		},
+	},
+	"delete": {
+		usage: "delete ENTITY",
+		help:  "Deletes an entity that isn't a player",
+		action: func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error {
+			entity, err := argInt64(args, 0)
+			if err != nil {
+				return err
+			}
+			_, err = client.DeleteEntity(ctx, &esive_grpc.DeleteEntityReq{Entity: entity})
+			return err
+		},
+	},
+	"tick": {
+		usage: "tick pause|resume|step [N]|DURATION",
+		help:  "Pauses or resumes the tick, runs N ticks (1 by default) and pauses, or changes its duration and resumes it. Eg: tick 200ms",
+		action: func(ctx context.Context, client esive_grpc.EsiveAdminClient, args []string) error {
+			if len(args) == 0 {
+				return errors.New("Missing arguments")
+			}
+			req := &esive_grpc.SetTickReq{}
+			switch args[0] {
+			case "pause":
+				req.Paused = true
+			case "resume":
+			case "step":
+				req.Step = 1
+				if len(args) > 1 {
+					n, err := strconv.Atoi(args[1])
+					if err != nil {
+						return err
+					}
+					req.Step = int32(n)
+				}
+			default:
+				ms, err := tickMilliseconds(args[0])
+				if err != nil {
+					return err
+				}
+				req.TickMilliseconds = ms
+			}
+			res, err := client.SetTick(ctx, req)
+			if err != nil {
+				return err
+			}
+			state := "running"
+			if res.Paused {
+				state = "paused"
+			}
+			fmt.Printf("Tick %d, %v, every %dms\n", res.Tick, state, res.TickMilliseconds)
+			return nil
+		},
+	},
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, found := commands[flag.Arg(0)]
+	if !found {
+		fmt.Fprintf(os.Stderr, "Unknown command %v\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	conn, err := grpc.Dial(*addr, grpc.WithInsecure())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "admin-token", *token)
+	if err := cmd.action(ctx, esive_grpc.NewEsiveAdminClient(conn), flag.Args()[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: esivectl [FLAGS] COMMAND [ARGS]\n\nCommands:\n")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %v\n    \t%v\n", commands[name].usage, commands[name].help)
+	}
+	fmt.Fprintf(os.Stderr, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+func argInt64(args []string, i int) (int64, error) {
+	if len(args) <= i {
+		return 0, errors.New("Missing arguments")
+	}
+	return strconv.ParseInt(args[i], 10, 64)
+}
+
+// tickMilliseconds parses the duration of a tick. Durations under a millisecond are rejected, a zero duration would
+// resume the tick without changing it.
+func tickMilliseconds(arg string) (int32, error) {
+	d, err := time.ParseDuration(arg)
+	if err != nil {
+		return 0, err
+	}
+	if d < time.Millisecond {
+		return 0, fmt.Errorf("A tick must last at least 1ms, got %v", arg)
+	}
+	if d.Milliseconds() > math.MaxInt32 {
+		return 0, fmt.Errorf("A tick can last at most %vms, got %v", math.MaxInt32, arg)
+	}
+	return int32(d.Milliseconds()), nil
+}
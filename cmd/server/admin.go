@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	components "github.com/code-cell/esive/components"
+	esive_grpc "github.com/code-cell/esive/grpc"
+	"github.com/code-cell/esive/systems"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// adminServer implements the EsiveAdmin service, so the server can be managed without its REPL.
+type adminServer struct {
+	server *server
+	logger *zap.Logger
+}
+
+func newAdminServer(s *server, logger *zap.Logger) *adminServer {
+	return &adminServer{
+		server: s,
+		logger: logger.With(zap.String("service", "admin")),
+	}
+}
+
+// adminAuthInterceptor rejects the calls to the admin service without the admin token. The service is disabled
+// if there is no token configured.
+func adminAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, "/grpc.EsiveAdmin/") {
+		return handler(ctx, req)
+	}
+	if config.Admin.Token == "" {
+		return nil, errors.New("The admin service is disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md["admin-token"]) != 1 || subtle.ConstantTimeCompare([]byte(md["admin-token"][0]), []byte(config.Admin.Token)) != 1 {
+		return nil, errors.New("Wrong admin token")
+	}
+	return handler(ctx, req)
+}
+
+func (a *adminServer) ListPlayers(ctx context.Context, req *esive_grpc.ListPlayersReq) (*esive_grpc.ListPlayersRes, error) {
+	a.server.playersMtx.Lock()
+	players := make([]*esive_grpc.Player, 0, len(a.server.players))
+	for _, playerData := range a.server.players {
+		players = append(players, &esive_grpc.Player{
+			Entity:    int64(playerData.Entity),
+			Name:      playerData.Name,
+			Connected: playerData.disconnected == nil,
+		})
+	}
+	a.server.playersMtx.Unlock()
+
+	for _, player := range players {
+		permission, err := systems.PermissionOf(ctx, components.Entity(player.Entity))
+		if err != nil {
+			return nil, err
+		}
+		player.Role = permission.String()
+		pos := &components.Position{}
+		if err := a.server.registry.LoadComponents(ctx, components.Entity(player.Entity), pos); err != nil {
+			return nil, err
+		}
+		player.Position = &esive_grpc.Position{X: pos.X, Y: pos.Y}
+	}
+	sort.Slice(players, func(i, j int) bool {
+		return players[i].Name < players[j].Name
+	})
+	return &esive_grpc.ListPlayersRes{Players: players}, nil
+}
+
+func (a *adminServer) InspectEntity(ctx context.Context, req *esive_grpc.InspectEntityReq) (*esive_grpc.InspectEntityRes, error) {
+	entityComponents, err := a.server.registry.Components(ctx, components.Entity(req.Entity))
+	if err != nil {
+		return nil, err
+	}
+	if len(entityComponents) == 0 {
+		return nil, fmt.Errorf("Entity %d not found", req.Entity)
+	}
+	res := &esive_grpc.InspectEntityRes{Components: map[string]string{}}
+	for _, component := range entityComponents {
+		res.Components[string(component.ProtoReflect().Descriptor().FullName().Name())] = protojson.Format(component)
+	}
+	return res, nil
+}
+
+// Teleport moves an entity with the actions of the next tick, like `/tp`, so it doesn't race with the rest of the
+// movement.
+func (a *adminServer) Teleport(ctx context.Context, req *esive_grpc.TeleportReq) (*esive_grpc.TeleportRes, error) {
+	entity := components.Entity(req.Entity)
+	found, err := a.hasPosition(ctx, entity)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Entity %d has no position", req.Entity)
+	}
+	x, y := req.Position.GetX(), req.Position.GetY()
+	a.logger.Info("teleporting", zap.Int64("entity_id", req.Entity), zap.Int64("x", x), zap.Int64("y", y))
+	tick := a.server.tick.Current()
+	a.server.actionsQueue.QueueInmediate(ctx, func(ctx context.Context) {
+		// It may have been deleted since, and moving it would create it again.
+		found, err := a.hasPosition(ctx, entity)
+		if err == nil && found {
+			err = a.server.movement.Teleport(ctx, tick, entity, x, y)
+		}
+		if err != nil {
+			a.logger.Error("error teleporting", zap.Int64("entity_id", req.Entity), zap.Error(err))
+		}
+	})
+	return &esive_grpc.TeleportRes{}, nil
+}
+
+// hasPosition returns whether the entity has a Position, without creating it.
+func (a *adminServer) hasPosition(ctx context.Context, entity components.Entity) (bool, error) {
+	entityComponents, err := a.server.registry.Components(ctx, entity)
+	if err != nil {
+		return false, err
+	}
+	for _, component := range entityComponents {
+		if _, ok := component.(*components.Position); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (a *adminServer) Kick(ctx context.Context, req *esive_grpc.KickReq) (*esive_grpc.KickRes, error) {
+	a.logger.Info("kicking", zap.String("name", req.Name), zap.String("reason", req.Reason))
+	found, err := a.server.Kick(ctx, req.Name, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%v isn't connected", req.Name)
+	}
+	return &esive_grpc.KickRes{}, nil
+}
+
+func (a *adminServer) Broadcast(ctx context.Context, req *esive_grpc.BroadcastReq) (*esive_grpc.BroadcastRes, error) {
+	a.logger.Info("broadcasting", zap.String("text", req.Text))
+	if err := a.server.chat.Broadcast(ctx, req.Text); err != nil {
+		return nil, err
+	}
+	return &esive_grpc.BroadcastRes{}, nil
+}
+
+func (a *adminServer) Spawn(ctx context.Context, req *esive_grpc.SpawnReq) (*esive_grpc.SpawnRes, error) {
+	entity, err := systems.Spawn(ctx, req.Prefab, req.Position.GetX(), req.Position.GetY())
+	if err != nil {
+		return nil, err
+	}
+	a.logger.Info("spawned", zap.String("prefab", req.Prefab), zap.Int64("entity_id", int64(entity)))
+	return &esive_grpc.SpawnRes{Entity: int64(entity)}, nil
+}
+
+func (a *adminServer) DeleteEntity(ctx context.Context, req *esive_grpc.DeleteEntityReq) (*esive_grpc.DeleteEntityRes, error) {
+	named := &components.Named{}
+	if err := a.server.registry.LoadComponents(ctx, components.Entity(req.Entity), named); err != nil {
+		return nil, err
+	}
+	// Their accounts point at them, even while they are offline.
+	if named.Name != "" {
+		return nil, fmt.Errorf("Entity %d is the player %v. Kick them instead", req.Entity, named.Name)
+	}
+	a.logger.Info("deleting entity", zap.Int64("entity_id", req.Entity))
+	if err := a.server.registry.DeleteEntity(ctx, components.Entity(req.Entity)); err != nil {
+		return nil, err
+	}
+	return &esive_grpc.DeleteEntityRes{}, nil
+}
+
+func (a *adminServer) SetTick(ctx context.Context, req *esive_grpc.SetTickReq) (*esive_grpc.SetTickRes, error) {
+	t := a.server.tick
+	if req.TickMilliseconds < 0 {
+		return nil, errors.New("The tick duration can't be negative")
+	}
+	if req.Step < 0 {
+		return nil, errors.New("The steps can't be negative")
+	}
+	if req.TickMilliseconds > 0 {
+		t.SetDelay(time.Duration(req.TickMilliseconds) * time.Millisecond)
+	}
+	if req.Step > 0 {
+		t.Step(int(req.Step))
+	} else if req.Paused {
+		t.Pause()
+	} else {
+		t.Resume()
+	}
+	a.logger.Info("tick changed", zap.Bool("paused", t.Paused()), zap.Duration("delay", t.Delay()))
+	return &esive_grpc.SetTickRes{
+		Tick:             t.Current(),
+		Paused:           t.Paused(),
+		TickMilliseconds: int32(t.Delay().Milliseconds()),
+	}, nil
+}
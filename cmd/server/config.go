@@ -0,0 +1,314 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config has every setting of the server. They are taken from the defaults, then the config file, then the
+// environment and at last the flags, every one overriding the previous.
+type Config struct {
+	Network NetworkConfig `yaml:"network"`
+	Storage StorageConfig `yaml:"storage"`
+	Tick    TickConfig    `yaml:"tick"`
+	Vision  VisionConfig  `yaml:"vision"`
+	Chat    ChatConfig    `yaml:"chat"`
+	Spawn   SpawnConfig   `yaml:"spawn"`
+	Players PlayersConfig `yaml:"players"`
+	Logging LoggingConfig `yaml:"logging"`
+	Admin   AdminConfig   `yaml:"admin"`
+}
+
+type NetworkConfig struct {
+	// Addr is where the gRPC server listens.
+	Addr string `yaml:"addr"`
+	// HTTPAddr is where /healthz, /readyz and /metrics are served.
+	HTTPAddr string `yaml:"http_addr"`
+	NatsURL  string `yaml:"nats_url"`
+}
+
+type StorageConfig struct {
+	RedisAddr     string `yaml:"redis_addr"`
+	RedisUsername string `yaml:"redis_username"`
+	RedisPassword string `yaml:"redis_password"`
+	// RedisFlush empties the whole database when the server starts.
+	RedisFlush bool `yaml:"redis_flush"`
+	ChunkSize  int  `yaml:"chunk_size"`
+}
+
+type TickConfig struct {
+	Duration time.Duration `yaml:"duration"`
+	// DayLength is how many ticks a day lasts, half of it being night. 0 disables nights.
+	DayLength int64 `yaml:"day_length"`
+}
+
+type VisionConfig struct {
+	// Radius is used for both visibility and chat.
+	Radius int `yaml:"radius"`
+	// ReadDistance is how far players can read notes from.
+	ReadDistance float64 `yaml:"read_distance"`
+}
+
+type ChatConfig struct {
+	LogSize      int           `yaml:"log_size"`
+	Backlog      int           `yaml:"backlog"`
+	Rate         float64       `yaml:"rate"`
+	Burst        int           `yaml:"burst"`
+	RepeatLimit  int           `yaml:"repeat_limit"`
+	BlockedWords []string      `yaml:"blocked_words"`
+	Warnings     int           `yaml:"warnings"`
+	Mute         time.Duration `yaml:"mute"`
+	NoteLimit    int           `yaml:"note_limit"`
+	NoteExpiry   time.Duration `yaml:"note_expiry"`
+	MailboxSize  int           `yaml:"mailbox_size"`
+}
+
+type SpawnConfig struct {
+	// Radius of the square around the origin where new players appear.
+	Radius int64 `yaml:"radius"`
+	// TestEntities are only created if the database was flushed, in a square of TestEntitiesRadius.
+	TestEntities       int   `yaml:"test_entities"`
+	TestEntitiesRadius int64 `yaml:"test_entities_radius"`
+}
+
+type PlayersConfig struct {
+	// Ops are granted a role when they join. Eg: alice or bob:moderator. The role is admin if it isn't given.
+	Ops               []string      `yaml:"ops"`
+	ResumeGrace       time.Duration `yaml:"resume_grace"`
+	ShutdownCountdown time.Duration `yaml:"shutdown_countdown"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout"`
+}
+
+type LoggingConfig struct {
+	// Level is one of debug, info, warn or error.
+	Level          string   `yaml:"level"`
+	Output         []string `yaml:"output"`
+	JaegerEndpoint string   `yaml:"jaeger_endpoint"`
+}
+
+type AdminConfig struct {
+	// Token has to be sent by the clients of the admin service. The service is disabled without one.
+	Token string `yaml:"token"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Network: NetworkConfig{
+			Addr:     ":9000",
+			HTTPAddr: ":9100",
+		},
+		Storage: StorageConfig{
+			RedisAddr:  "localhost:6379",
+			RedisFlush: true,
+			ChunkSize:  15,
+		},
+		Tick: TickConfig{
+			Duration:  300 * time.Millisecond,
+			DayLength: 2000,
+		},
+		Vision: VisionConfig{
+			Radius:       15,
+			ReadDistance: 5,
+		},
+		Chat: ChatConfig{
+			LogSize:     100,
+			Backlog:     20,
+			Rate:        1,
+			Burst:       5,
+			RepeatLimit: 3,
+			Warnings:    2,
+			Mute:        30 * time.Second,
+			NoteLimit:   10,
+			MailboxSize: 50,
+		},
+		Spawn: SpawnConfig{
+			Radius:             5,
+			TestEntities:       100,
+			TestEntitiesRadius: 30,
+		},
+		Players: PlayersConfig{
+			ResumeGrace:       30 * time.Second,
+			ShutdownCountdown: 10 * time.Second,
+			ShutdownTimeout:   5 * time.Second,
+		},
+		Logging: LoggingConfig{
+			Level:          "debug",
+			Output:         []string{"server.log"},
+			JaegerEndpoint: "http://localhost:14268/api/traces",
+		},
+	}
+}
+
+// RegisterFlags adds a flag for the most common settings, set to their current value.
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.Network.Addr, "addr", c.Network.Addr, "Address the server listens on")
+	fs.StringVar(&c.Network.HTTPAddr, "http-addr", c.Network.HTTPAddr, "Address health checks and metrics are served on")
+	fs.StringVar(&c.Network.NatsURL, "nats-url", c.Network.NatsURL, "NATS server url")
+	fs.StringVar(&c.Storage.RedisAddr, "redis-addr", c.Storage.RedisAddr, "Redis address")
+	fs.StringVar(&c.Storage.RedisUsername, "redis-username", c.Storage.RedisUsername, "Redis username")
+	fs.StringVar(&c.Storage.RedisPassword, "redis-password", c.Storage.RedisPassword, "Redis password")
+	fs.BoolVar(&c.Storage.RedisFlush, "redis-flush", c.Storage.RedisFlush, "If enabled, it empties all database when the server starts")
+	fs.IntVar(&c.Storage.ChunkSize, "chunk-size", c.Storage.ChunkSize, "Size of the chunks the world is split into")
+	fs.DurationVar(&c.Tick.Duration, "tick", c.Tick.Duration, "Tick duration")
+	fs.Int64Var(&c.Tick.DayLength, "day-length", c.Tick.DayLength, "How many ticks a day lasts, half of it being night. 0 disables nights")
+	fs.IntVar(&c.Vision.Radius, "visibility", c.Vision.Radius, "Default radius used for visibility and chat")
+	fs.Float64Var(&c.Vision.ReadDistance, "read-distance", c.Vision.ReadDistance, "How far players can read notes from")
+	fs.IntVar(&c.Chat.LogSize, "chat-log-size", c.Chat.LogSize, "How many messages are kept in every chat log")
+	fs.IntVar(&c.Chat.Backlog, "chat-backlog", c.Chat.Backlog, "How many past messages players get when they join")
+	fs.Float64Var(&c.Chat.Rate, "chat-rate", c.Chat.Rate, "How many chat messages per second a player can send in the long run")
+	fs.IntVar(&c.Chat.Burst, "chat-burst", c.Chat.Burst, "How many chat messages a player can send in a row")
+	fs.IntVar(&c.Chat.RepeatLimit, "chat-repeat-limit", c.Chat.RepeatLimit, "How many times in a row a player can send the same message. 0 disables the check")
+	fs.Var((*commaList)(&c.Chat.BlockedWords), "chat-blocked-words", "Comma separated list of words censored in the chat")
+	fs.IntVar(&c.Chat.Warnings, "chat-warnings", c.Chat.Warnings, "How many chat offenses are warned about before muting the player")
+	fs.DurationVar(&c.Chat.Mute, "chat-mute", c.Chat.Mute, "How long the first mute lasts. Every following one lasts twice the previous")
+	fs.IntVar(&c.Chat.NoteLimit, "note-limit", c.Chat.NoteLimit, "How many notes a player can have in the world at the same time. 0 disables the limit")
+	fs.DurationVar(&c.Chat.NoteExpiry, "note-expiry", c.Chat.NoteExpiry, "How long notes last. 0 keeps them forever")
+	fs.IntVar(&c.Chat.MailboxSize, "mailbox-size", c.Chat.MailboxSize, "How many mails a player can keep. 0 disables the limit")
+	fs.Int64Var(&c.Spawn.Radius, "spawn-radius", c.Spawn.Radius, "Radius of the square around the origin where new players appear")
+	fs.IntVar(&c.Spawn.TestEntities, "test-entities", c.Spawn.TestEntities, "Amount of test entities (a #). This will only trigger if redis database is flushed.")
+	fs.Var((*commaList)(&c.Players.Ops), "ops", "Comma separated list of players granted a role when they join. Eg: alice,bob:moderator. The role is admin if it isn't given")
+	fs.DurationVar(&c.Players.ResumeGrace, "resume-grace", c.Players.ResumeGrace, "How long players stay in the world after losing their connection, so they can resume their session. 0 removes them at once")
+	fs.DurationVar(&c.Players.ShutdownCountdown, "shutdown-countdown", c.Players.ShutdownCountdown, "How long players are warned before the server shuts down")
+	fs.DurationVar(&c.Players.ShutdownTimeout, "shutdown-timeout", c.Players.ShutdownTimeout, "How long the server waits for the last tick and the open connections when shutting down")
+	fs.StringVar(&c.Logging.Level, "log-level", c.Logging.Level, "Log level: debug, info, warn or error")
+	fs.StringVar(&c.Admin.Token, "admin-token", c.Admin.Token, "Token the clients of the admin service have to send. The service is disabled without one")
+	fs.StringVar(&c.Logging.JaegerEndpoint, "jaeger-endpoint", c.Logging.JaegerEndpoint, "Jaeger collector endpoint")
+}
+
+// Load reads the YAML config file at `path`, if it isn't empty, and the environment on top of the flags already
+// parsed. The flags set explicitly take precedence.
+func (c *Config) Load(path string, fs *flag.FlagSet) error {
+	explicit := map[string]string{}
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = f.Value.String()
+	})
+
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		decoder := yaml.NewDecoder(f)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(c); err != nil && err != io.EOF {
+			return fmt.Errorf("reading %v: %w", path, err)
+		}
+	}
+
+	if err := applyEnv(reflect.ValueOf(c).Elem(), "ESIVE"); err != nil {
+		return err
+	}
+
+	for name, value := range explicit {
+		if err := fs.Set(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redactedSecret replaces the secrets that are set when the config is printed.
+const redactedSecret = "<redacted>"
+
+// Print writes the config as YAML, so it can be used as a config file. Secrets are replaced with a placeholder, as
+// the output tends to end up in logs.
+func (c *Config) Print(w io.Writer) error {
+	printed := *c
+	for _, secret := range []*string{&printed.Storage.RedisPassword, &printed.Admin.Token} {
+		if *secret != "" {
+			*secret = redactedSecret
+		}
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&printed); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// applyEnv sets every field from the environment variable named after its path of YAML keys, in upper case. Eg:
+// ESIVE_CHAT_LOG_SIZE for chat.log_size. Lists are comma separated.
+func applyEnv(v reflect.Value, prefix string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		name := prefix + "_" + strings.ToUpper(strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0])
+		if field.Kind() == reflect.Struct {
+			if err := applyEnv(field, name); err != nil {
+				return err
+			}
+			continue
+		}
+		value, found := os.LookupEnv(name)
+		if !found {
+			continue
+		}
+		if err := setField(field, value); err != nil {
+			return fmt.Errorf("%v: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func setField(field reflect.Value, value string) error {
+	switch field.Interface().(type) {
+	case string:
+		field.SetString(value)
+	case bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case int, int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case time.Duration:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+	case float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case []string:
+		return (*commaList)(field.Addr().Interface().(*[]string)).Set(value)
+	default:
+		return fmt.Errorf("unsupported type %v", field.Type())
+	}
+	return nil
+}
+
+// commaList is a list flag, written as comma separated values.
+type commaList []string
+
+func (l *commaList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *commaList) Set(value string) error {
+	*l = nil
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
@@ -2,12 +2,17 @@ package main
 
 import (
 	"context"
+	cryptorand "crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/rand"
 	"net"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/code-cell/esive/actions"
 	components "github.com/code-cell/esive/components"
@@ -18,6 +23,8 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/stats"
 )
@@ -26,6 +33,13 @@ type PlayerData struct {
 	Entity  components.Entity
 	Updater *updater
 	Name    string
+	Session string
+
+	// disconnected is set while the player has no connection, until the grace period to resume its session ends.
+	disconnected *time.Timer
+
+	// kicked is closed when the player is kicked, to end its streams.
+	kicked chan struct{}
 }
 
 type server struct {
@@ -35,17 +49,40 @@ type server struct {
 	vision       *systems.VisionSystem
 	movement     *systems.MovementSystem
 	chat         *systems.ChatSystem
+	mailbox      *components.Mailbox
+	accounts     *components.Accounts
 	tick         *tick.Tick
 	logger       *zap.Logger
 
 	playersMtx sync.Mutex
 	players    map[string]*PlayerData
 
+	// sessions has the name of the account of every token given on Login. Tokens are deleted with their players, or
+	// after unusedSessionExpiry if nobody joins with them.
+	sessionsMtx sync.Mutex
+	sessions    map[string]string
+
+	// roles are granted to the players by name when they join.
+	rolesMtx sync.Mutex
+	roles    map[string]systems.Permission
+
 	visibilityFlushCh  []chan struct{}
 	visibilityFlushMtx sync.Mutex
+
+	// finishedTick is the last tick whose services are done.
+	finishedTick int64
+
+	// closing is set once the server starts shutting down, so nobody else joins.
+	closingMtx sync.Mutex
+	closing    bool
+	grpcServer *grpc.Server
+	health     *health.Server
 }
 
-func newServer(logger *zap.Logger, actionsQueue *actions.ActionsQueue, registry *components.Registry, geo *components.Geo, vision *systems.VisionSystem, movement *systems.MovementSystem, chat *systems.ChatSystem, t *tick.Tick) *server {
+// unusedSessionExpiry is how long a token given on Login lasts if nobody joins with it.
+const unusedSessionExpiry = time.Minute
+
+func newServer(logger *zap.Logger, actionsQueue *actions.ActionsQueue, registry *components.Registry, geo *components.Geo, vision *systems.VisionSystem, movement *systems.MovementSystem, chat *systems.ChatSystem, mailbox *components.Mailbox, accounts *components.Accounts, t *tick.Tick) *server {
 	s := &server{
 		actionsQueue:      actionsQueue,
 		registry:          registry,
@@ -53,10 +90,15 @@ func newServer(logger *zap.Logger, actionsQueue *actions.ActionsQueue, registry
 		vision:            vision,
 		movement:          movement,
 		chat:              chat,
+		mailbox:           mailbox,
+		accounts:          accounts,
+		sessions:          map[string]string{},
 		tick:              t,
 		players:           map[string]*PlayerData{},
+		roles:             map[string]systems.Permission{},
 		logger:            logger,
 		visibilityFlushCh: make([]chan struct{}, 0),
+		health:            health.NewServer(),
 	}
 	return s
 }
@@ -73,6 +115,56 @@ func getTickFromCtx(ctx context.Context) (int64, error) {
 	return strconv.ParseInt(tick[0], 10, 64)
 }
 
+// finishTick flushes the visibility updates of a tick, once all its services are done.
+func (s *server) finishTick(tick int64) error {
+	atomic.StoreInt64(&s.finishedTick, tick)
+	return s.flushVisibilityUpdates()
+}
+
+// WaitForTick waits until the services of `tick` are done.
+func (s *server) WaitForTick(ctx context.Context, tick int64) error {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for atomic.LoadInt64(&s.finishedTick) < tick {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// checkTick rejects the requests for ticks that already started. Zero is the current tick, for requests that don't
+// depend on it.
+func (s *server) checkTick(tick int64) error {
+	if tick != 0 && tick <= s.tick.Current() {
+		return errors.New("can't send requests for current or past ticks")
+	}
+	return nil
+}
+
+// addVisibilityFlush returns a channel that gets a message after every tick, when its visibility updates are done.
+func (s *server) addVisibilityFlush() chan struct{} {
+	flushCh := make(chan struct{})
+	s.visibilityFlushMtx.Lock()
+	s.visibilityFlushCh = append(s.visibilityFlushCh, flushCh)
+	s.visibilityFlushMtx.Unlock()
+	return flushCh
+}
+
+func (s *server) removeVisibilityFlush(flushCh chan struct{}) {
+	s.visibilityFlushMtx.Lock()
+	defer s.visibilityFlushMtx.Unlock()
+	for i := 0; i < len(s.visibilityFlushCh); i++ {
+		if s.visibilityFlushCh[i] == flushCh {
+			s.visibilityFlushCh[i] = s.visibilityFlushCh[len(s.visibilityFlushCh)-1]
+			s.visibilityFlushCh = s.visibilityFlushCh[:len(s.visibilityFlushCh)-1]
+			break
+		}
+	}
+}
+
 func (s *server) flushVisibilityUpdates() error {
 	s.visibilityFlushMtx.Lock()
 	defer s.visibilityFlushMtx.Unlock()
@@ -90,48 +182,63 @@ func (s *server) SetVelocity(ctx context.Context, v *esive_grpc.Velocity) (*esiv
 	if err != nil {
 		return nil, err
 	}
-	playerData := s.playerData(ctx)
+	s.setVelocity(ctx, s.playerData(ctx), tick, v)
+	return &esive_grpc.MoveRes{}, nil
+}
+
+func (s *server) setVelocity(ctx context.Context, playerData *PlayerData, tick int64, v *esive_grpc.Velocity) {
 	s.actionsQueue.QueueAction(ctx, tick, func(ctx context.Context) {
 		s.movement.SetVelocity(ctx, tick, playerData.Entity, int64(v.X), int64(v.Y))
 	})
-	return &esive_grpc.MoveRes{}, nil
 }
 
 func (s *server) Read(ctx context.Context, req *esive_grpc.ReadReq) (*esive_grpc.ReadRes, error) {
 	playerID := ctx.Value("playerID").(string)
 	s.logger.Debug("Player read", zap.String("playerID", playerID))
 
-	playerData := s.playerData(ctx)
+	s.read(ctx, s.playerData(ctx), req.Position)
+	return &esive_grpc.ReadRes{}, nil
+}
+
+// read sends the player what's written at `position`, if it's close enough.
+func (s *server) read(ctx context.Context, playerData *PlayerData, position *esive_grpc.Position) {
 	pos := &components.Position{}
 	err := s.registry.LoadComponents(ctx, playerData.Entity, pos)
 	if err != nil {
 		panic(err)
 	}
 
-	if components.Distance(req.Position.X, req.Position.Y, pos.X, pos.Y) > 5 {
-		playerData.Updater.Chats <- &esive_grpc.ChatMessage{
-			From: "<SYSTEM>",
-			Text: "You can read only up to 5 tiles from you. Get closer and try again.",
-		}
-		return &esive_grpc.ReadRes{}, nil
+	if components.Distance(position.GetX(), position.GetY(), pos.X, pos.Y) > float32(config.Vision.ReadDistance) {
+		playerData.Updater.HandleChatMessage(&systems.ChatMessage{
+			Kind:      systems.ChatKindError,
+			Message:   fmt.Sprintf("You can read only up to %v tiles from you. Get closer and try again.", config.Vision.ReadDistance),
+			Tick:      s.tick.Current(),
+			Timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+		})
+		return
 	}
 
-	_, _, extras, err := s.geo.FindInRange(ctx, req.Position.X, req.Position.Y, 0, &components.Readable{})
+	_, _, extras, err := s.geo.FindInRange(ctx, position.GetX(), position.GetY(), 0, &components.Readable{}, &components.Authored{})
 	if err != nil {
 		panic(err)
 	}
 	for _, entityExtras := range extras {
 		readable := entityExtras[0].(*components.Readable)
+		authored := entityExtras[1].(*components.Authored)
 		if readable.Text == "" {
 			continue
 		}
-		playerData.Updater.Chats <- &esive_grpc.ChatMessage{
-			From: "<SYSTEM>",
-			Text: readable.Text,
+		text := readable.Text
+		if authored.Name != "" {
+			text = fmt.Sprintf("Message from %v: %v", authored.Name, readable.Text)
 		}
+		playerData.Updater.HandleChatMessage(&systems.ChatMessage{
+			Kind:      systems.ChatKindSystem,
+			Message:   text,
+			Tick:      s.tick.Current(),
+			Timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+		})
 	}
-
-	return &esive_grpc.ReadRes{}, nil
 }
 
 func (s *server) Say(ctx context.Context, req *esive_grpc.SayReq) (*esive_grpc.SayRes, error) {
@@ -150,47 +257,277 @@ func (s *server) Say(ctx context.Context, req *esive_grpc.SayReq) (*esive_grpc.S
 	return &esive_grpc.SayRes{}, nil
 }
 
+func (s *server) CompleteCommand(ctx context.Context, req *esive_grpc.CompleteCommandReq) (*esive_grpc.CompleteCommandRes, error) {
+	playerID := ctx.Value("playerID").(string)
+	s.logger.Debug("Player complete command", zap.String("playerID", playerID), zap.String("text", req.Text))
+
+	playerData := s.playerData(ctx)
+	completions, err := s.chat.Complete(ctx, playerData.Entity, req.Text)
+	if err != nil {
+		panic(err)
+	}
+	return &esive_grpc.CompleteCommandRes{Completions: completions}, nil
+}
+
+func (s *server) ListMail(ctx context.Context, req *esive_grpc.ListMailReq) (*esive_grpc.ListMailRes, error) {
+	playerID := ctx.Value("playerID").(string)
+	s.logger.Debug("Player list mail", zap.String("playerID", playerID))
+
+	playerData := s.playerData(ctx)
+	mails, err := s.mailbox.List(ctx, playerData.Name)
+	if err != nil {
+		panic(err)
+	}
+	res := &esive_grpc.ListMailRes{Mails: make([]*esive_grpc.Mail, 0, len(mails))}
+	for _, mail := range mails {
+		res.Mails = append(res.Mails, &esive_grpc.Mail{
+			Id:        mail.Id,
+			From:      mail.From,
+			Timestamp: mail.Timestamp,
+			Read:      mail.Read,
+		})
+	}
+	return res, nil
+}
+
+func (s *server) ReadMail(ctx context.Context, req *esive_grpc.ReadMailReq) (*esive_grpc.ReadMailRes, error) {
+	playerID := ctx.Value("playerID").(string)
+	s.logger.Debug("Player read mail", zap.String("playerID", playerID), zap.Int64("id", req.Id))
+
+	playerData := s.playerData(ctx)
+	mail, found, err := s.mailbox.Read(ctx, playerData.Name, req.Id)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return nil, errors.New("No such mail")
+	}
+	return &esive_grpc.ReadMailRes{
+		Mail: &esive_grpc.Mail{
+			Id:        mail.Id,
+			From:      mail.From,
+			Timestamp: mail.Timestamp,
+			Read:      mail.Read,
+			Text:      mail.Text,
+		},
+	}, nil
+}
+
+func (s *server) DeleteMail(ctx context.Context, req *esive_grpc.DeleteMailReq) (*esive_grpc.DeleteMailRes, error) {
+	playerID := ctx.Value("playerID").(string)
+	s.logger.Debug("Player delete mail", zap.String("playerID", playerID), zap.Int64("id", req.Id))
+
+	playerData := s.playerData(ctx)
+	found, err := s.mailbox.Delete(ctx, playerData.Name, req.Id)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return nil, errors.New("No such mail")
+	}
+	return &esive_grpc.DeleteMailRes{}, nil
+}
+
+func (s *server) Login(ctx context.Context, req *esive_grpc.LoginReq) (*esive_grpc.LoginRes, error) {
+	playerID := ctx.Value("playerID").(string)
+	s.logger.Debug("Player login", zap.String("playerID", playerID), zap.String("name", req.Name))
+
+	if req.Name == "" || strings.ContainsAny(req.Name, " \t\n") {
+		return nil, errors.New("Invalid name")
+	}
+	_, err := s.accounts.Login(ctx, req.Name, req.Password)
+	if err == components.ErrWrongPassword {
+		return nil, err
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	token := make([]byte, 16)
+	if _, err := cryptorand.Read(token); err != nil {
+		panic(err)
+	}
+	res := &esive_grpc.LoginRes{Token: hex.EncodeToString(token)}
+	s.sessionsMtx.Lock()
+	s.sessions[res.Token] = req.Name
+	s.sessionsMtx.Unlock()
+	time.AfterFunc(unusedSessionExpiry, func() {
+		if !s.sessionInUse(res.Token) {
+			s.deleteSession(res.Token)
+		}
+	})
+	return res, nil
+}
+
 func (s *server) Join(ctx context.Context, req *esive_grpc.JoinReq) (*esive_grpc.JoinRes, error) {
 	playerID := ctx.Value("playerID").(string)
 	s.logger.Debug("Player joined", zap.String("playerID", playerID))
 
-	for _, d := range s.players {
-		if d.Name == req.Name {
-			return nil, errors.New("Name taken")
-		}
+	name, ok := s.sessionName(ctx)
+	if !ok {
+		return nil, errors.New("Not logged in")
 	}
+	session, _ := s.sessionToken(ctx)
 
-	entity, err := s.registry.NewEntity(ctx)
+	// Joining again while the connection is dropped resumes the session.
+	resumed, err := s.resumePlayer(playerID, session, func(d *PlayerData) bool {
+		return d.Name == name
+	})
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	if resumed != nil {
+		return s.joinRes(resumed), nil
 	}
 
-	err = s.registry.CreateComponents(ctx, entity,
-		&components.Named{Name: req.Name},
-		&components.Position{X: rand.Int63n(10) - 5, Y: rand.Int63n(10) - 5},
-		&components.Moveable{},
-		&components.Speaker{Range: float32(*visibilityRadius)},
-		&components.Render{Char: "@", Color: 0x5bd54dff},
-		&components.Looker{},
-	)
+	entity, err := s.character(ctx, name)
 	if err != nil {
 		panic(err)
 	}
 
+	if err := s.mailbox.Open(ctx, name); err != nil {
+		panic(err)
+	}
+
+	s.rolesMtx.Lock()
+	permission, found := s.roles[name]
+	s.rolesMtx.Unlock()
+	if found {
+		if err := systems.SetRole(ctx, entity, permission); err != nil {
+			panic(err)
+		}
+	}
+
 	updater := newUpdater()
 	s.vision.AddUpdater(entity, updater)
-	s.chat.AddListener(entity, updater)
+	s.chat.AddListener(entity, name, updater)
 
-	s.players[playerID] = &PlayerData{
+	s.playersMtx.Lock()
+	playerData := &PlayerData{
 		Entity:  entity,
 		Updater: updater,
-		Name:    req.Name,
+		Name:    name,
+		Session: session,
+		kicked:  make(chan struct{}),
+	}
+	s.players[playerID] = playerData
+	s.playersMtx.Unlock()
+
+	return s.joinRes(playerData), nil
+}
+
+func (s *server) Resume(ctx context.Context, req *esive_grpc.ResumeReq) (*esive_grpc.JoinRes, error) {
+	playerID := ctx.Value("playerID").(string)
+	s.logger.Debug("Player resumed", zap.String("playerID", playerID))
+
+	resumed, err := s.resumePlayer(playerID, req.Token, func(d *PlayerData) bool {
+		return d.Session == req.Token
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resumed == nil {
+		return nil, errors.New("No session to resume")
 	}
+	return s.joinRes(resumed), nil
+}
 
+func (s *server) joinRes(playerData *PlayerData) *esive_grpc.JoinRes {
 	return &esive_grpc.JoinRes{
-		PlayerId:         int64(entity),
-		TickMilliseconds: int32(s.tick.Delay.Milliseconds()),
-	}, nil
+		PlayerId:         int64(playerData.Entity),
+		TickMilliseconds: int32(s.tick.Delay().Milliseconds()),
+		DayLength:        config.Tick.DayLength,
+	}
+}
+
+// character puts the character of an account back in the world, creating it the first time.
+func (s *server) character(ctx context.Context, name string) (components.Entity, error) {
+	account, found, err := s.accounts.Get(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, errors.New("Unknown account")
+	}
+	if account.Entity != 0 {
+		entity := components.Entity(account.Entity)
+		named := &components.Named{}
+		if err := s.registry.LoadComponents(ctx, entity, named); err != nil {
+			return 0, err
+		}
+		if named.Name == name {
+			// It isn't detached if the server stopped while the player was connected.
+			if _, err := systems.RestoreCharacter(ctx, entity); err != nil {
+				return 0, err
+			}
+			return entity, nil
+		}
+	}
+
+	entity, err := s.registry.NewEntity(ctx)
+	if err != nil {
+		return 0, err
+	}
+	err = s.registry.CreateComponents(ctx, entity,
+		&components.Named{Name: name},
+		&components.Position{X: randomOffset(config.Spawn.Radius), Y: randomOffset(config.Spawn.Radius)},
+		&components.Moveable{},
+		&components.Speaker{Range: float32(config.Vision.Radius)},
+		&components.Render{Char: "@", Color: 0x5bd54dff},
+		&components.Looker{Radius: float32(config.Vision.Radius)},
+		&components.LightSource{Radius: 4, Color: 0xffd27fff},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return entity, s.accounts.SetEntity(ctx, name, entity)
+}
+
+// randomOffset returns a random coordinate in [-radius, radius).
+func randomOffset(radius int64) int64 {
+	if radius <= 0 {
+		return 0
+	}
+	return rand.Int63n(2*radius) - radius
+}
+
+// sessionToken returns the token in the `session` metadata.
+func (s *server) sessionToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["session"]) != 1 {
+		return "", false
+	}
+	return md["session"][0], true
+}
+
+// sessionInUse returns whether a player joined with the token.
+func (s *server) sessionInUse(token string) bool {
+	s.playersMtx.Lock()
+	defer s.playersMtx.Unlock()
+	for _, playerData := range s.players {
+		if playerData.Session == token {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *server) deleteSession(token string) {
+	s.sessionsMtx.Lock()
+	defer s.sessionsMtx.Unlock()
+	delete(s.sessions, token)
+}
+
+// sessionName returns the name of the account logged in with the token in the `session` metadata.
+func (s *server) sessionName(ctx context.Context) (string, bool) {
+	token, ok := s.sessionToken(ctx)
+	if !ok {
+		return "", false
+	}
+	s.sessionsMtx.Lock()
+	defer s.sessionsMtx.Unlock()
+	name, found := s.sessions[token]
+	return name, found
 }
 
 func (s *server) ChatUpdates(req *esive_grpc.ChatUpdatesReq, stream esive_grpc.Esive_ChatUpdatesServer) error {
@@ -199,77 +536,112 @@ func (s *server) ChatUpdates(req *esive_grpc.ChatUpdatesReq, stream esive_grpc.E
 	s.logger.Debug("Player subscribed to chat updates", zap.String("playerID", playerID))
 	playerData := s.playerData(ctx)
 
-	for message := range playerData.Updater.Chats {
+	backlog, err := s.chat.Backlog(ctx, playerData.Entity, config.Chat.Backlog)
+	if err != nil {
+		panic(err)
+	}
+	for _, message := range backlog {
 		stream.Send(&esive_grpc.ChatUpdatesRes{
-			Message: message,
+			Message: chatMessageFromSystem(message),
 		})
 	}
-	return nil
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-playerData.kicked:
+			return nil
+		case message := <-playerData.Updater.Chats:
+			stream.Send(&esive_grpc.ChatUpdatesRes{
+				Message: message,
+			})
+		}
+	}
 }
 
-func (s *server) TickUpdates(req *esive_grpc.TickUpdatesReq, stream esive_grpc.Esive_TickUpdatesServer) error {
-	ctx := stream.Context()
+func (s *server) ChatHistory(ctx context.Context, req *esive_grpc.ChatHistoryReq) (*esive_grpc.ChatHistoryRes, error) {
 	playerID := ctx.Value("playerID").(string)
-	s.logger.Debug("Player subscribed to visibility updates", zap.String("playerID", playerID))
-	playerData := s.playerData(ctx)
+	s.logger.Debug("Player chat history", zap.String("playerID", playerID), zap.String("channel", req.Channel))
 
-	viewItems, err := s.vision.LookAll(ctx, playerData.Entity)
+	limit := int(req.Limit)
+	if limit <= 0 || limit > config.Chat.LogSize {
+		limit = config.Chat.LogSize
+	}
+
+	playerData := s.playerData(ctx)
+	messages, err := s.chat.History(ctx, playerData.Entity, req.Channel, req.Before, limit)
+	if err == systems.ErrNotInChannel {
+		return nil, err
+	}
 	if err != nil {
 		panic(err)
 	}
+	res := &esive_grpc.ChatHistoryRes{Messages: make([]*esive_grpc.ChatMessage, 0, len(messages))}
+	for _, message := range messages {
+		res.Messages = append(res.Messages, chatMessageFromSystem(message))
+	}
+	return res, nil
+}
+
+func (s *server) TickUpdates(req *esive_grpc.TickUpdatesReq, stream esive_grpc.Esive_TickUpdatesServer) error {
+	ctx := stream.Context()
+	playerID := ctx.Value("playerID").(string)
+	s.logger.Debug("Player subscribed to visibility updates", zap.String("playerID", playerID))
+	playerData := s.playerData(ctx)
 
+	// Every new stream starts with the full state, so clients can resync by opening a new one.
+	delay := s.tick.Delay()
+	stream.Send(&esive_grpc.TickUpdatesRes{
+		VisibilityUpdates: s.resyncUpdates(ctx, playerData),
+		Resync:            true,
+		TickMilliseconds:  int32(delay.Milliseconds()),
+	})
 	res := &esive_grpc.TickUpdatesRes{VisibilityUpdates: make([]*esive_grpc.VisibilityUpdate, 0)}
-	for _, viewItem := range viewItems {
-		res.VisibilityUpdates = append(res.VisibilityUpdates, &esive_grpc.VisibilityUpdate{
-			Action: esive_grpc.VisibilityUpdate_ADD,
-			Renderable: &esive_grpc.Renderable{
-				Char:  viewItem.Char,
-				Color: viewItem.Color,
-				Id:    viewItem.ID,
-				Position: &esive_grpc.Position{
-					X: viewItem.X,
-					Y: viewItem.Y,
-				},
-				Velocity: &esive_grpc.Velocity{
-					X: viewItem.VelX,
-					Y: viewItem.VelY,
-				},
-			},
-		})
-	}
-	stream.Send(res)
-	res.VisibilityUpdates = make([]*esive_grpc.VisibilityUpdate, 0)
 
-	flushCh := make(chan struct{})
-	s.visibilityFlushMtx.Lock()
-	s.visibilityFlushCh = append(s.visibilityFlushCh, flushCh)
-	s.visibilityFlushMtx.Unlock()
+	flushCh := s.addVisibilityFlush()
+	defer s.removeVisibilityFlush(flushCh)
 
 	exit := false
 	for !exit {
 		select {
 		case <-stream.Context().Done():
 			exit = true
+		case <-playerData.kicked:
+			exit = true
 		case <-flushCh:
-			if len(res.VisibilityUpdates) > 0 {
+			if newDelay := s.tick.Delay(); newDelay != delay {
+				delay = newDelay
+				res.TickMilliseconds = int32(delay.Milliseconds())
+			}
+			if len(res.VisibilityUpdates) > 0 || res.TickMilliseconds != 0 {
 				stream.Send(res)
+				countVisibilityUpdates(res.VisibilityUpdates)
 				res.VisibilityUpdates = make([]*esive_grpc.VisibilityUpdate, 0)
+				res.TickMilliseconds = 0
 			}
 		case update := <-playerData.Updater.Updates:
 			res.VisibilityUpdates = append(res.VisibilityUpdates, update)
 		}
 	}
+	return nil
+}
 
-	s.visibilityFlushMtx.Lock()
-	for i := 0; i < len(s.visibilityFlushCh); i++ {
-		if s.visibilityFlushCh[i] == flushCh {
-			s.visibilityFlushCh[i] = s.visibilityFlushCh[len(s.visibilityFlushCh)-1]
-			s.visibilityFlushCh = s.visibilityFlushCh[:len(s.visibilityFlushCh)-1]
-			break
-		}
+// resyncUpdates returns the updates with everything the player sees, which the following updates are based on.
+func (s *server) resyncUpdates(ctx context.Context, playerData *PlayerData) []*esive_grpc.VisibilityUpdate {
+	viewItems, err := s.vision.LookAll(ctx, playerData.Entity)
+	if err != nil {
+		panic(err)
+	}
+	resync := playerData.Updater.Resync(viewItems)
+	countVisibilityUpdates(resync)
+	return resync
+}
+
+func countVisibilityUpdates(updates []*esive_grpc.VisibilityUpdate) {
+	for _, update := range updates {
+		visibilityUpdatesMetric.WithLabelValues(strings.ToLower(update.Action.String())).Inc()
 	}
-	s.visibilityFlushMtx.Unlock()
-	return nil
 }
 
 type serverStats struct {
@@ -294,21 +666,187 @@ func (h *serverStats) HandleConn(ctx context.Context, s stats.ConnStats) {
 	case *stats.ConnEnd:
 		playerID := ctx.Value("playerID").(string)
 		h.logger.Debug("Player disconnected", zap.String("playerID", playerID))
-		playerData, ok := h.server.players[playerID]
-		if ok {
-			err := h.server.registry.DeleteEntity(ctx, playerData.Entity)
-			if err != nil {
-				panic(err)
-			}
-			h.server.playersMtx.Lock()
-			delete(h.server.players, playerID)
-			h.server.playersMtx.Unlock()
+		if err := h.server.disconnectPlayer(ctx, playerID); err != nil {
+			panic(err)
 		}
 
 		break
 	}
 }
 
+// removePlayer takes the character of a player out of the world, if it's still in. It returns whether it was, so
+// only one of the callers racing to remove a player ends its streams.
+func (s *server) removePlayer(ctx context.Context, playerID string) (bool, error) {
+	s.playersMtx.Lock()
+	playerData, ok := s.players[playerID]
+	delete(s.players, playerID)
+	if ok && playerData.disconnected != nil {
+		playerData.disconnected.Stop()
+	}
+	s.playersMtx.Unlock()
+	if !ok {
+		return false, nil
+	}
+	s.deleteSession(playerData.Session)
+	playerData.Updater.Close()
+	s.chat.RemoveListener(playerData.Entity)
+	return true, systems.DetachCharacter(ctx, playerData.Entity)
+}
+
+// disconnectPlayer keeps the character of a player that lost its connection in the world, frozen, so it can resume
+// its session. It's removed when the grace period ends.
+func (s *server) disconnectPlayer(ctx context.Context, playerID string) error {
+	if config.Players.ResumeGrace <= 0 {
+		_, err := s.removePlayer(ctx, playerID)
+		return err
+	}
+
+	s.playersMtx.Lock()
+	defer s.playersMtx.Unlock()
+	playerData, ok := s.players[playerID]
+	if !ok || playerData.disconnected != nil {
+		return nil
+	}
+	playerData.Updater.Close()
+	s.actionsQueue.QueueInmediate(context.Background(), func(ctx context.Context) {
+		s.movement.SetVelocity(ctx, s.tick.Current(), playerData.Entity, 0, 0)
+	})
+	playerData.disconnected = time.AfterFunc(config.Players.ResumeGrace, func() {
+		s.playersMtx.Lock()
+		expired := s.players[playerID] == playerData
+		s.playersMtx.Unlock()
+		if !expired {
+			return
+		}
+		s.logger.Debug("Player session expired", zap.String("playerID", playerID))
+		if _, err := s.removePlayer(context.Background(), playerID); err != nil {
+			panic(err)
+		}
+	})
+	return nil
+}
+
+// resumePlayer moves the first player that matches to a new connection, if it lost its own. It returns nil if no
+// player matches, and an error if it's still connected or its grace period is over.
+func (s *server) resumePlayer(playerID, session string, match func(*PlayerData) bool) (*PlayerData, error) {
+	s.playersMtx.Lock()
+	var oldID string
+	var old *PlayerData
+	for id, d := range s.players {
+		if match(d) {
+			oldID, old = id, d
+			break
+		}
+	}
+	if old == nil {
+		s.playersMtx.Unlock()
+		return nil, nil
+	}
+	if old.disconnected == nil {
+		s.playersMtx.Unlock()
+		return nil, errors.New("Already connected")
+	}
+	if !old.disconnected.Stop() {
+		s.playersMtx.Unlock()
+		return nil, errors.New("Session expired")
+	}
+	delete(s.players, oldID)
+	resumed := &PlayerData{
+		Entity:  old.Entity,
+		Updater: newUpdater(),
+		Name:    old.Name,
+		Session: session,
+		kicked:  make(chan struct{}),
+	}
+	s.players[playerID] = resumed
+	s.playersMtx.Unlock()
+	if old.Session != session {
+		// The player logged in again, the old token isn't needed anymore.
+		s.deleteSession(old.Session)
+	}
+
+	s.vision.AddUpdater(resumed.Entity, resumed.Updater)
+	s.chat.AddListener(resumed.Entity, resumed.Name, resumed.Updater)
+	return resumed, nil
+}
+
+// Kick disconnects the player called `name`, telling them why. It returns false if there is no player with that
+// name.
+func (s *server) Kick(ctx context.Context, name, reason string) (bool, error) {
+	s.playersMtx.Lock()
+	playerID := ""
+	for id, playerData := range s.players {
+		if playerData.Name == name {
+			playerID = id
+			break
+		}
+	}
+	playerData := s.players[playerID]
+	s.playersMtx.Unlock()
+	if playerData == nil {
+		return false, nil
+	}
+
+	text := "You were kicked from the server."
+	if reason != "" {
+		text = fmt.Sprintf("You were kicked from the server: %v", reason)
+	}
+	// Nobody receives it if the player isn't subscribed to the chat.
+	select {
+	case playerData.Updater.Chats <- &esive_grpc.ChatMessage{Kind: esive_grpc.ChatMessage_ERROR, Text: text, Tick: s.tick.Current(), Timestamp: time.Now().UnixNano() / int64(time.Millisecond)}:
+	case <-time.After(time.Second):
+	}
+
+	removed, err := s.removePlayer(ctx, playerID)
+	if removed {
+		close(playerData.kicked)
+	}
+	return removed, err
+}
+
+func (s *server) kickCommand(ctx context.Context, _ int64, _ components.Entity, listener systems.ChatListener, args systems.ChatArgs) {
+	player := args.String("player")
+	found, err := s.Kick(ctx, player, args.String("reason"))
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		listener.HandleChatMessage(&systems.ChatMessage{
+			Kind:    systems.ChatKindError,
+			Message: fmt.Sprintf("There is no player called %v.", player),
+		})
+		return
+	}
+	listener.HandleChatMessage(&systems.ChatMessage{
+		Kind:    systems.ChatKindSystem,
+		Message: fmt.Sprintf("Kicked %v.", player),
+	})
+}
+
+// Grant gives a role to the player called `name`, now if it's connected and every time it joins. It returns false
+// if the player isn't connected.
+func (s *server) Grant(ctx context.Context, name string, permission systems.Permission) (bool, error) {
+	s.rolesMtx.Lock()
+	s.roles[name] = permission
+	s.rolesMtx.Unlock()
+
+	s.playersMtx.Lock()
+	var entity components.Entity
+	connected := false
+	for _, playerData := range s.players {
+		if playerData.Name == name {
+			entity = playerData.Entity
+			connected = true
+			break
+		}
+	}
+	s.playersMtx.Unlock()
+	if !connected {
+		return false, nil
+	}
+	return true, systems.SetRole(ctx, entity, permission)
+}
+
 func (s *server) playerData(ctx context.Context) *PlayerData {
 	s.playersMtx.Lock()
 	defer s.playersMtx.Unlock()
@@ -316,8 +854,8 @@ func (s *server) playerData(ctx context.Context) *PlayerData {
 	return s.players[playerID]
 }
 
-func (s *server) Serve() {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", 9000))
+func (s *server) Serve(admin esive_grpc.EsiveAdminServer) {
+	lis, err := net.Listen("tcp", config.Network.Addr)
 	if err != nil {
 		s.logger.Fatal("failed to listen", zap.Error(err))
 	}
@@ -325,19 +863,122 @@ func (s *server) Serve() {
 		grpc.StatsHandler(&serverStats{s, s.logger}),
 		grpc.ChainUnaryInterceptor(
 			otelgrpc.UnaryServerInterceptor(),
+			adminAuthInterceptor,
 			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-				t, err := getTickFromCtx(ctx)
-				cur := s.tick.Current()
-				if t != 0 && t <= cur {
-					return nil, errors.New("can't send requests for current or past ticks")
+				// Other services, like the health one, don't need players.
+				if !strings.HasPrefix(info.FullMethod, "/grpc.Esive/") {
+					return handler(ctx, req)
+				}
+				t, _ := getTickFromCtx(ctx)
+				if err := s.checkTick(t); err != nil {
+					return nil, err
 				}
 				grpc.SetHeader(ctx, metadata.Pairs("tick", strconv.FormatInt(s.tick.Current(), 10)))
+				switch info.FullMethod {
+				case "/grpc.Esive/Login", "/grpc.Esive/Join", "/grpc.Esive/Resume":
+					if s.isClosing() {
+						return nil, errors.New("Server shutting down")
+					}
+				}
+				if info.FullMethod == "/grpc.Esive/Login" {
+					return handler(ctx, req)
+				}
+				if _, ok := s.sessionName(ctx); !ok {
+					return nil, errors.New("Not logged in")
+				}
+				if info.FullMethod != "/grpc.Esive/Join" && info.FullMethod != "/grpc.Esive/Resume" && s.playerData(ctx) == nil {
+					// Kicked players keep their connection, but they aren't in the game anymore.
+					return nil, errors.New("not joined")
+				}
 				return handler(ctx, req)
 			},
 		),
-		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+		grpc.ChainStreamInterceptor(
+			otelgrpc.StreamServerInterceptor(),
+			func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				if !strings.HasPrefix(info.FullMethod, "/grpc.Esive/") {
+					return handler(srv, ss)
+				}
+				if _, ok := s.sessionName(ss.Context()); !ok {
+					return errors.New("Not logged in")
+				}
+				if s.playerData(ss.Context()) == nil {
+					return errors.New("not joined")
+				}
+				return handler(srv, ss)
+			},
+		),
 	)
 	esive_grpc.RegisterEsiveServer(grpcServer, s)
+	esive_grpc.RegisterEsiveAdminServer(grpcServer, admin)
+	healthpb.RegisterHealthServer(grpcServer, s.health)
+	s.closingMtx.Lock()
+	s.grpcServer = grpcServer
+	s.closingMtx.Unlock()
 	s.logger.Info("Running...")
 	grpcServer.Serve(lis)
 }
+
+func (s *server) isClosing() bool {
+	s.closingMtx.Lock()
+	defer s.closingMtx.Unlock()
+	return s.closing
+}
+
+// Countdown stops players from joining, and warns the ones playing that the server shuts down in `d`.
+func (s *server) Countdown(ctx context.Context, d time.Duration) error {
+	s.closingMtx.Lock()
+	s.closing = true
+	s.closingMtx.Unlock()
+	s.health.Shutdown()
+
+	total := int(d / time.Second)
+	for left := total; left > 0; left-- {
+		if left == total || left%10 == 0 || left <= 5 {
+			if err := s.chat.Broadcast(ctx, fmt.Sprintf("The server shuts down in %d seconds.", left)); err != nil {
+				return err
+			}
+		}
+		time.Sleep(time.Second)
+	}
+	return s.chat.Broadcast(ctx, "The server is shutting down.")
+}
+
+// Shutdown takes every player out of the world, keeping their characters, and stops serving. Players still
+// connected after `timeout` are cut off.
+func (s *server) Shutdown(ctx context.Context, timeout time.Duration) error {
+	s.playersMtx.Lock()
+	players := make(map[string]*PlayerData, len(s.players))
+	for playerID, playerData := range s.players {
+		players[playerID] = playerData
+	}
+	s.playersMtx.Unlock()
+
+	for playerID, playerData := range players {
+		removed, err := s.removePlayer(ctx, playerID)
+		if removed {
+			close(playerData.kicked)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	s.closingMtx.Lock()
+	grpcServer := s.grpcServer
+	s.closingMtx.Unlock()
+	if grpcServer == nil {
+		return nil
+	}
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		grpcServer.Stop()
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/code-cell/esive/queue"
+	"github.com/code-cell/esive/tick"
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// healthChecker tells whether the server is ready to take players: redis and NATS are reachable, the tick is
+// running and it isn't shutting down. It serves it over HTTP and the gRPC health service.
+type healthChecker struct {
+	server *server
+	rdb    *redis.Client
+	q      *queue.Queue
+	tick   *tick.Tick
+	logger *zap.Logger
+
+	// lastTick is when the last tick started, in unix nanoseconds.
+	lastTick int64
+}
+
+func newHealthChecker(server *server, rdb *redis.Client, q *queue.Queue, t *tick.Tick, logger *zap.Logger) *healthChecker {
+	return &healthChecker{
+		server: server,
+		rdb:    rdb,
+		q:      q,
+		tick:   t,
+		logger: logger.With(zap.String("service", "health")),
+	}
+}
+
+// HandleTick is a tick subscriber, to know the tick is running.
+func (h *healthChecker) HandleTick(ctx context.Context, tick int64) {
+	atomic.StoreInt64(&h.lastTick, time.Now().UnixNano())
+}
+
+// Ready returns why the server isn't ready, or nil if it is.
+func (h *healthChecker) Ready(ctx context.Context) error {
+	if h.server.isClosing() {
+		return errors.New("shutting down")
+	}
+	if err := h.rdb.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("redis: %w", err)
+	}
+	if !h.q.Connected() {
+		return errors.New("nats: not connected")
+	}
+	// Some leeway, as a tick can take a bit longer than expected. A paused tick was paused on purpose.
+	lastTick := atomic.LoadInt64(&h.lastTick)
+	if !h.tick.Paused() && (lastTick == 0 || time.Since(time.Unix(0, lastTick)) > 3*h.tick.Delay()) {
+		return errors.New("tick: not running")
+	}
+	return nil
+}
+
+// Watch keeps the status of the gRPC health service up to date, checking every `interval`.
+func (h *healthChecker) Watch(grpcHealth *health.Server, interval time.Duration) {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		err := h.Ready(ctx)
+		cancel()
+		if err != nil {
+			grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+		} else {
+			grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+		}
+		if h.server.isClosing() {
+			return
+		}
+		time.Sleep(interval)
+	}
+}
+
+// Serve serves /healthz, which only tells the server is up, /readyz and /metrics for Prometheus.
+func (h *healthChecker) Serve(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), time.Second)
+		defer cancel()
+		if err := h.Ready(ctx); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+	mux.Handle("/metrics", promhttp.Handler())
+
+	h.logger.Info("Serving health and metrics", zap.String("addr", addr))
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		h.logger.Error("failed to serve health and metrics", zap.Error(err))
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"flag"
 	"log"
-	"math/rand"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/code-cell/esive/actions"
@@ -24,38 +27,47 @@ import (
 )
 
 var (
-	visibilityRadius    = flag.Int("visibility", 15, "Radius used for visibility and for chunk size")
-	initialTestEntities = flag.Int("test-entities", 100, "Amount of test entities (a #). This will only trigger if redis database is flushed.")
-	redisAddr           = flag.String("redis-addr", "localhost:6379", "Redis address")
-	redisUsername       = flag.String("redis-username", "", "Redis username")
-	redisPassword       = flag.String("redis-password", "", "Redis password")
-	redisFlush          = flag.Bool("redis-flush", true, "If enabled, it empties all database when the server starts")
-	jeagerEndpoint      = flag.String("jaeger-endpoint", "http://localhost:14268/api/traces", "Jaeger collector endpoint")
-	natsURL             = flag.String("nats-url", "", "NATS server url")
-	tickDuration        = flag.Duration("tick", 300*time.Millisecond, "Tick duration")
+	configFile  = flag.String("config", "", "Path to a YAML config file. Settings can also be given as environment variables, like ESIVE_CHAT_LOG_SIZE for chat.log_size, and flags")
+	printConfig = flag.Bool("print-config", false, "Prints the effective configuration and exits")
+
+	config = DefaultConfig()
 )
 
+func init() {
+	config.RegisterFlags(flag.CommandLine)
+}
+
 func main() {
 	flag.Parse()
+	if err := config.Load(*configFile, flag.CommandLine); err != nil {
+		log.Fatal(err)
+	}
+	if *printConfig {
+		if err := config.Print(os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
 	flush := initTracer()
 	defer flush()
 
 	logConfig := zap.NewDevelopmentConfig()
-	logConfig.OutputPaths = []string{
-		"server.log",
+	if err := logConfig.Level.UnmarshalText([]byte(config.Logging.Level)); err != nil {
+		log.Fatal(err)
 	}
+	logConfig.OutputPaths = config.Logging.Output
 	logger, err := logConfig.Build()
 	if err != nil {
 		panic(err)
 	}
 
 	rdb := redis.NewClient(&redis.Options{
-		Addr:     *redisAddr,
-		Username: *redisUsername,
-		Password: *redisPassword,
+		Addr:     config.Storage.RedisAddr,
+		Username: config.Storage.RedisUsername,
+		Password: config.Storage.RedisPassword,
 	})
-	if *redisFlush == true {
+	if config.Storage.RedisFlush {
 		rdb.FlushAll(context.Background())
 	}
 	rdb.AddHook(redisotel.TracingHook{})
@@ -63,28 +75,48 @@ func main() {
 	actionsQueue := actions.NewActionsQueue()
 	store := components.NewRedisStore(rdb, logger)
 	registry := components.NewRegistry(store, logger)
-	geo := components.NewGeo(registry, store, *visibilityRadius, logger)
+	geo := components.NewGeo(registry, store, config.Storage.ChunkSize, logger)
 	systems.SetRegistry(registry)
 	systems.SetGeo(geo)
+	systems.SetNoteIndex(components.NewNoteIndex(store, logger))
 
-	vision := systems.NewVisionSystem(*visibilityRadius)
+	vision := systems.NewVisionSystem(config.Vision.Radius)
+	vision.SetDayCycle(tick.DayCycle{Length: config.Tick.DayLength})
+	if err := vision.Init(context.Background()); err != nil {
+		panic(err)
+	}
 	movement := systems.NewMovementSystem(vision)
-	chat := systems.NewChatSystem(actionsQueue, movement, registry)
-
-	err = queue.SetupNats(*natsURL)
+	chatLog := components.NewChatLog(store, config.Chat.LogSize, logger)
+	chat := systems.NewChatSystem(actionsQueue, movement, registry, chatLog)
+	chat.SetModerator(systems.NewChatModerator(systems.ChatModerationConfig{
+		Rate:         config.Chat.Rate,
+		Burst:        config.Chat.Burst,
+		RepeatLimit:  config.Chat.RepeatLimit,
+		BlockedWords: config.Chat.BlockedWords,
+		Warnings:     config.Chat.Warnings,
+		MuteDuration: config.Chat.Mute,
+	}))
+	notes := systems.NewNoteSystem(config.Chat.NoteLimit, config.Chat.NoteExpiry)
+	chat.SetNoteSystem(notes)
+	accounts := components.NewAccounts(store, logger)
+	mailbox := components.NewMailbox(store, config.Chat.MailboxSize, logger)
+	chat.SetMailbox(mailbox, accounts)
+
+	err = queue.SetupNats(config.Network.NatsURL)
 	if err != nil {
 		panic(err)
 	}
 
-	q := queue.NewQueue(*natsURL)
+	q := queue.NewQueue(config.Network.NatsURL)
 	if err := q.Connect(); err != nil {
 		panic(err)
 	}
 
-	tp := NewTickProcessor(logger, q, actionsQueue, movement, vision)
+	t := tick.NewTick(0, config.Tick.Duration)
+	tp := NewTickProcessor(logger, q, t, actionsQueue, movement, vision, notes)
 	tp.Init()
 
-	t := tick.NewTick(0, *tickDuration)
+	chat.SetTick(t)
 	t.AddSubscriber(q.HandleTick)
 
 	registry.OnCreateComponent(func(ctx context.Context, entity components.Entity, component proto.Message) {
@@ -97,46 +129,111 @@ func main() {
 		vision.HandleRemovedComponent(ctx, t.Current(), string(componentType), entity)
 	})
 
-	if *redisFlush == true {
+	if config.Storage.RedisFlush {
 		// Only create initial test entities if database was flushed
 		go func() {
-			for i := 0; i < *initialTestEntities; i++ {
-				entity, err := registry.NewEntity(context.Background())
-				if err != nil {
+			for i := 0; i < config.Spawn.TestEntities; i++ {
+				if _, err := systems.Spawn(context.Background(), "wall", randomOffset(config.Spawn.TestEntitiesRadius), randomOffset(config.Spawn.TestEntitiesRadius)); err != nil {
 					panic(err)
 				}
-				err = registry.CreateComponents(context.Background(), entity,
-					&components.Position{
-						X: rand.Int63n(60) - 30,
-						Y: rand.Int63n(60) - 30,
-					},
-					&components.Render{Char: "#", Color: 0xaf8769ff},
-				)
-				if err != nil {
+			}
+			// Some lamps, so the world isn't completely dark at night.
+			for i := 0; i < config.Spawn.TestEntities/10; i++ {
+				if _, err := systems.Spawn(context.Background(), "lamp", randomOffset(config.Spawn.TestEntitiesRadius), randomOffset(config.Spawn.TestEntitiesRadius)); err != nil {
 					panic(err)
 				}
 			}
 		}()
 	}
 
-	s := newServer(logger, actionsQueue, registry, geo, vision, movement, chat, t)
+	s := newServer(logger, actionsQueue, registry, geo, vision, movement, chat, mailbox, accounts, t)
+	for _, op := range config.Players.Ops {
+		if op == "" {
+			continue
+		}
+		name, role := op, "admin"
+		if i := strings.Index(op, ":"); i >= 0 {
+			name, role = op[:i], op[i+1:]
+		}
+		permission, err := systems.ParsePermission(role)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if _, err := s.Grant(context.Background(), name, permission); err != nil {
+			panic(err)
+		}
+	}
+	chat.Commands().AddCommand(&systems.ChatCommand{
+		Command:    "kick",
+		Help:       "Disconnects a player. Eg: /kick alice Spamming",
+		Args:       []systems.ChatArg{{Name: "player", Type: systems.ArgPlayer}, {Name: "reason", Type: systems.ArgRest, Optional: true}},
+		Permission: systems.PermissionModerator,
+		Action:     s.kickCommand,
+	})
 
 	go q.Consume("tick-services-finished", "grpc-flush", &queue.TickServicesFinished{}, func(_ *nats.Msg, m proto.Message) {
-		s.flushVisibilityUpdates()
+		s.finishTick(m.(*queue.TickServicesFinished).Tick)
 	})
 
+	registerServerMetrics(s, geo, logger)
+	healthChecker := newHealthChecker(s, rdb, q, t, logger)
+	t.AddSubscriber(healthChecker.HandleTick)
+	go healthChecker.Watch(s.health, time.Second)
+	go healthChecker.Serve(config.Network.HTTPAddr)
+
 	go t.Start()
-	go s.Serve()
+	admin := newAdminServer(s, logger)
+	go s.Serve(admin)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM)
+	replDone := make(chan struct{})
+	go func() {
+		repl := NewRepl(s, admin, t, movement, vision)
+		repl.Run()
+		close(replDone)
+	}()
+	select {
+	case <-replDone:
+	case sig := <-signals:
+		logger.Info("Received signal", zap.Stringer("signal", sig))
+	}
+	shutdown(logger, s, t, q, rdb)
+}
+
+// shutdown warns the players, lets the current tick complete and takes them out of the world before closing every
+// connection, so their characters are kept as they were.
+func shutdown(logger *zap.Logger, s *server, t *tick.Tick, q *queue.Queue, rdb *redis.Client) {
+	ctx := context.Background()
+	logger.Info("Shutting down")
+	log.Print("Shutting down...")
 
-	repl := NewRepl(s, t, movement)
-	repl.Run()
+	if err := s.Countdown(ctx, config.Players.ShutdownCountdown); err != nil {
+		logger.Error("Failed to warn the players", zap.Error(err))
+	}
+
+	t.Stop()
+	waitCtx, cancel := context.WithTimeout(ctx, config.Players.ShutdownTimeout)
+	if err := s.WaitForTick(waitCtx, t.Current()); err != nil {
+		logger.Warn("The last tick didn't complete", zap.Int64("tick", t.Current()), zap.Error(err))
+	}
+	cancel()
+
+	if err := s.Shutdown(ctx, config.Players.ShutdownTimeout); err != nil {
+		logger.Error("Failed to remove the players", zap.Error(err))
+	}
+	q.Close()
+	if err := rdb.Close(); err != nil {
+		logger.Error("Failed to close redis", zap.Error(err))
+	}
+	logger.Info("Shut down")
 }
 
 // initTracer creates a new trace provider instance and registers it as global trace provider.
 func initTracer() func() {
 	// Create and install Jaeger export pipeline.
 	flush, err := jaeger.InstallNewPipeline(
-		jaeger.WithCollectorEndpoint(*jeagerEndpoint),
+		jaeger.WithCollectorEndpoint(config.Logging.JaegerEndpoint),
 		jaeger.WithSDKOptions(
 			sdktrace.WithSampler(sdktrace.AlwaysSample()),
 			sdktrace.WithResource(resource.NewWithAttributes(
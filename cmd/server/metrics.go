@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/code-cell/esive/components"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	tickDurationMetric = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "esive_tick_duration_seconds",
+		Help:    "How long it takes to process a tick.",
+		Buckets: prometheus.ExponentialBuckets(0.001, 2, 12),
+	})
+	tickOverrunsMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "esive_tick_overruns_total",
+		Help: "How many ticks took longer to process than the tick duration.",
+	})
+	queueLatencyMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "esive_queue_latency_seconds",
+		Help:    "How long requests through the queue take to get a response.",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 10),
+	}, []string{"subject"})
+	visibilityUpdatesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "esive_visibility_updates_sent_total",
+		Help: "How many visibility updates were sent to the players.",
+	}, []string{"action"})
+)
+
+// registerServerMetrics adds the metrics taken from the state of the server when they are scraped.
+func registerServerMetrics(s *server, geo *components.Geo, logger *zap.Logger) {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "esive_connected_players",
+		Help: "How many players are connected.",
+	}, func() float64 {
+		s.playersMtx.Lock()
+		defer s.playersMtx.Unlock()
+		connected := 0
+		for _, playerData := range s.players {
+			if playerData.disconnected == nil {
+				connected++
+			}
+		}
+		return float64(connected)
+	})
+	prometheus.MustRegister(&chunkCollector{geo: geo, logger: logger})
+}
+
+// chunkCollector reports how many chunks have entities and how the entities are spread among them when it's scraped.
+// Counting them scans every chunk, so the counts are reused for `chunkMetricsInterval`.
+type chunkCollector struct {
+	geo    *components.Geo
+	logger *zap.Logger
+
+	mtx       sync.Mutex
+	counts    map[components.Chunk]int64
+	countedAt time.Time
+}
+
+const chunkMetricsInterval = time.Minute
+
+var (
+	occupiedChunksDesc   = prometheus.NewDesc("esive_occupied_chunks", "How many chunks have entities.", nil, nil)
+	chunkEntitiesDesc    = prometheus.NewDesc("esive_chunk_entities", "How many entities there are in the chunks that have any.", nil, nil)
+	chunkEntitiesBuckets = prometheus.ExponentialBuckets(1, 2, 11)
+)
+
+func (c *chunkCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- occupiedChunksDesc
+	ch <- chunkEntitiesDesc
+}
+
+func (c *chunkCollector) Collect(ch chan<- prometheus.Metric) {
+	counts, err := c.chunkEntities()
+	if err != nil {
+		c.logger.Error("error counting the entities in chunks", zap.Error(err))
+		return
+	}
+	buckets := make(map[float64]uint64, len(chunkEntitiesBuckets))
+	sum := 0.0
+	for _, count := range counts {
+		sum += float64(count)
+		for _, bucket := range chunkEntitiesBuckets {
+			if float64(count) <= bucket {
+				buckets[bucket]++
+			}
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(occupiedChunksDesc, prometheus.GaugeValue, float64(len(counts)))
+	ch <- prometheus.MustNewConstHistogram(chunkEntitiesDesc, uint64(len(counts)), sum, buckets)
+}
+
+// chunkEntities returns the entities in every chunk, counting them again if the last count is too old.
+func (c *chunkCollector) chunkEntities() (map[components.Chunk]int64, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.counts != nil && time.Since(c.countedAt) < chunkMetricsInterval {
+		return c.counts, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	counts, err := c.geo.ChunkEntities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.counts = counts
+	c.countedAt = time.Now()
+	return counts, nil
+}
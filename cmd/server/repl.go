@@ -6,11 +6,15 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	components "github.com/code-cell/esive/components"
+	esive_grpc "github.com/code-cell/esive/grpc"
 	"github.com/code-cell/esive/systems"
 	"github.com/code-cell/esive/tick"
 	"github.com/peterh/liner"
@@ -31,15 +35,19 @@ type Repl struct {
 	commands []replCommand
 
 	grpcServer *server
+	admin      *adminServer
 	tick       *tick.Tick
 	movement   *systems.MovementSystem
+	vision     *systems.VisionSystem
 }
 
-func NewRepl(grpcServer *server, tick *tick.Tick, movement *systems.MovementSystem) *Repl {
+func NewRepl(grpcServer *server, admin *adminServer, tick *tick.Tick, movement *systems.MovementSystem, vision *systems.VisionSystem) *Repl {
 	r := &Repl{
 		grpcServer: grpcServer,
+		admin:      admin,
 		tick:       tick,
 		movement:   movement,
+		vision:     vision,
 	}
 
 	r.commands = append(r.commands, replCommand{
@@ -64,9 +72,19 @@ func NewRepl(grpcServer *server, tick *tick.Tick, movement *systems.MovementSyst
 		keyword: "info",
 		help:    "Displays server information",
 		action: func(_ []string) {
+			res, err := r.admin.ListPlayers(context.TODO(), &esive_grpc.ListPlayersReq{})
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			fmt.Printf("Tick: %v\n", r.tick.Current())
 			players := []string{}
-			for _, player := range r.grpcServer.players {
-				players = append(players, fmt.Sprintf("%v", player.Entity))
+			for _, player := range res.Players {
+				status := ""
+				if !player.Connected {
+					status = " (disconnected)"
+				}
+				players = append(players, fmt.Sprintf("%v %v (%v) at [%v,%v]%v", player.Entity, player.Name, player.Role, player.Position.GetX(), player.Position.GetY(), status))
 			}
 			fmt.Printf("Players:\n\t%v\n", strings.Join(players, "\n\t"))
 		},
@@ -99,6 +117,324 @@ func NewRepl(grpcServer *server, tick *tick.Tick, movement *systems.MovementSyst
 		},
 	})
 
+	r.commands = append(r.commands, replCommand{
+		keyword: "radius",
+		help:    "`radius PLAYER_ID R`. Changes how far the player PLAYER_ID sees",
+		action: func(args []string) {
+			entity, err := argInt64(args, 0)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			radius, err := argInt64(args, 1)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			err = r.vision.SetRadius(context.TODO(), components.Entity(entity), float32(radius))
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "stealth",
+		help:    "`stealth PLAYER_ID LEVEL`. Hides the player PLAYER_ID from the lookers with a lower perception",
+		action: func(args []string) {
+			entity, err := argInt64(args, 0)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			level, err := argInt64(args, 1)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			err = r.vision.SetStealth(context.TODO(), components.Entity(entity), int32(level))
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "perception",
+		help:    "`perception PLAYER_ID LEVEL`. Lets the player PLAYER_ID see stealthy entities up to LEVEL",
+		action: func(args []string) {
+			entity, err := argInt64(args, 0)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			level, err := argInt64(args, 1)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			err = r.vision.SetPerception(context.TODO(), components.Entity(entity), int32(level))
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "op",
+		help:    "`op NAME [ROLE]`. Makes the player NAME an admin, or gives them ROLE (player, moderator or admin)",
+		action: func(args []string) {
+			if len(args) == 0 {
+				fmt.Printf("Error: Missing arguments\n")
+				return
+			}
+			permission := systems.PermissionAdmin
+			if len(args) > 1 {
+				var err error
+				permission, err = systems.ParsePermission(args[1])
+				if err != nil {
+					fmt.Printf("Error: %v\n", err.Error())
+					return
+				}
+			}
+			r.grant(args[0], permission)
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "deop",
+		help:    "`deop NAME`. Makes the player NAME a regular player",
+		action: func(args []string) {
+			if len(args) == 0 {
+				fmt.Printf("Error: Missing arguments\n")
+				return
+			}
+			r.grant(args[0], systems.PermissionPlayer)
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "inspect",
+		help:    "`inspect ENTITY`. Shows every component of ENTITY",
+		action: func(args []string) {
+			entity, err := argInt64(args, 0)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			res, err := r.admin.InspectEntity(context.TODO(), &esive_grpc.InspectEntityReq{Entity: entity})
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			names := make([]string, 0, len(res.Components))
+			for name := range res.Components {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Printf("%v: %v\n", name, res.Components[name])
+			}
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "spawn",
+		help:    "`spawn X Y CHAR [COLOR]`. Creates an entity at [X,Y] that looks like CHAR, in the hex RGBA COLOR (white by default)",
+		action: func(args []string) {
+			x, err := argInt64(args, 0)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			y, err := argInt64(args, 1)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			if len(args) < 3 {
+				fmt.Printf("Error: Missing arguments\n")
+				return
+			}
+			color := uint64(0xffffffff)
+			if len(args) > 3 {
+				color, err = strconv.ParseUint(strings.TrimPrefix(args[3], "0x"), 16, 32)
+				if err != nil {
+					fmt.Printf("Error: %v\n", err.Error())
+					return
+				}
+			}
+			entity, err := r.grpcServer.registry.NewEntity(context.TODO())
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			err = r.grpcServer.registry.CreateComponents(context.TODO(), entity,
+				&components.Position{X: x, Y: y},
+				&components.Render{Char: args[2], Color: uint32(color)},
+			)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			fmt.Printf("Spawned %v\n", entity)
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "kill",
+		help:    "`kill ENTITY`. Deletes ENTITY, unless it's a player",
+		action: func(args []string) {
+			entity, err := argInt64(args, 0)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			if _, err := r.admin.DeleteEntity(context.TODO(), &esive_grpc.DeleteEntityReq{Entity: entity}); err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "chunks",
+		help:    "Lists the chunks with entities, and how many there are in each",
+		action: func(_ []string) {
+			counts, err := r.grpcServer.geo.ChunkEntities(context.TODO())
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			chunks := make([]components.Chunk, 0, len(counts))
+			total := int64(0)
+			for chunk, count := range counts {
+				chunks = append(chunks, chunk)
+				total += count
+			}
+			sort.Slice(chunks, func(i, j int) bool {
+				if chunks[i].X != chunks[j].X {
+					return chunks[i].X < chunks[j].X
+				}
+				return chunks[i].Y < chunks[j].Y
+			})
+			for _, chunk := range chunks {
+				fmt.Printf("[%v,%v]: %v\n", chunk.X, chunk.Y, counts[chunk])
+			}
+			fmt.Printf("%v entities in %v chunks\n", total, len(chunks))
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "find",
+		help:    "`find X Y R`. Lists the entities up to R units away from [X,Y]",
+		action: func(args []string) {
+			x, err := argInt64(args, 0)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			y, err := argInt64(args, 1)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			radius, err := argInt64(args, 2)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			entities, positions, extras, err := r.grpcServer.geo.FindInRange(context.TODO(), x, y, float32(radius), &components.Render{}, &components.Named{})
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			for i, entity := range entities {
+				render := extras[i][0].(*components.Render)
+				named := extras[i][1].(*components.Named)
+				fmt.Printf("%v %q at [%v,%v] %v\n", entity, render.Char, positions[i].X, positions[i].Y, named.Name)
+			}
+			fmt.Printf("%v entities found\n", len(entities))
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "say",
+		help:    "`say TEXT`. Sends TEXT to every player as a system message",
+		action: func(args []string) {
+			if len(args) == 0 {
+				fmt.Printf("Error: Missing arguments\n")
+				return
+			}
+			if _, err := r.admin.Broadcast(context.TODO(), &esive_grpc.BroadcastReq{Text: strings.Join(args, " ")}); err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "kick",
+		help:    "`kick NAME [REASON]`. Disconnects the player NAME",
+		action: func(args []string) {
+			if len(args) == 0 {
+				fmt.Printf("Error: Missing arguments\n")
+				return
+			}
+			if _, err := r.admin.Kick(context.TODO(), &esive_grpc.KickReq{Name: args[0], Reason: strings.Join(args[1:], " ")}); err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+		},
+	})
+
+	r.commands = append(r.commands, replCommand{
+		keyword: "tick",
+		help:    "`tick pause|resume|step [N]|DURATION`. Pauses or resumes the tick, runs N ticks (1 by default) and pauses, or changes its duration and resumes it",
+		action: func(args []string) {
+			if len(args) == 0 {
+				fmt.Printf("Error: Missing arguments\n")
+				return
+			}
+			req := &esive_grpc.SetTickReq{}
+			switch args[0] {
+			case "pause":
+				req.Paused = true
+			case "resume":
+			case "step":
+				req.Step = 1
+				if len(args) > 1 {
+					n, err := strconv.Atoi(args[1])
+					if err != nil {
+						fmt.Printf("Error: %v\n", err.Error())
+						return
+					}
+					req.Step = int32(n)
+				}
+			default:
+				ms, err := tickMilliseconds(args[0])
+				if err != nil {
+					fmt.Printf("Error: %v\n", err.Error())
+					return
+				}
+				req.TickMilliseconds = ms
+			}
+			res, err := r.admin.SetTick(context.TODO(), req)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err.Error())
+				return
+			}
+			state := "running"
+			if res.Paused {
+				state = "paused"
+			}
+			fmt.Printf("Tick %d, %v, every %dms\n", res.Tick, state, res.TickMilliseconds)
+		},
+	})
+
 	return r
 }
 
@@ -161,9 +497,38 @@ func (r *Repl) runInput(input string) error {
 	return fmt.Errorf("command `%v` not found", input)
 }
 
+func (r *Repl) grant(name string, permission systems.Permission) {
+	connected, err := r.grpcServer.Grant(context.TODO(), name, permission)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err.Error())
+		return
+	}
+	if !connected {
+		fmt.Printf("%v isn't connected. They will be %v when they join.\n", name, permission)
+		return
+	}
+	fmt.Printf("%v is now %v.\n", name, permission)
+}
+
 func argInt64(args []string, i int) (int64, error) {
 	if len(args) <= i {
 		return 0, errors.New("Missing arguments")
 	}
 	return strconv.ParseInt(args[i], 10, 64)
 }
+
+// tickMilliseconds parses the duration of a tick. Durations under a millisecond are rejected, a zero duration would
+// resume the tick without changing it.
+func tickMilliseconds(arg string) (int32, error) {
+	d, err := time.ParseDuration(arg)
+	if err != nil {
+		return 0, err
+	}
+	if d < time.Millisecond {
+		return 0, fmt.Errorf("A tick must last at least 1ms, got %v", arg)
+	}
+	if d.Milliseconds() > math.MaxInt32 {
+		return 0, fmt.Errorf("A tick can last at most %vms, got %v", math.MaxInt32, arg)
+	}
+	return int32(d.Milliseconds()), nil
+}
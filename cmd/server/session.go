@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	esive_grpc "github.com/code-cell/esive/grpc"
+	"go.uber.org/zap"
+)
+
+// Session sends the player everything they get on TickUpdates and ChatUpdates, and runs the commands they send, in
+// order. Every command gets an ack.
+func (s *server) Session(stream esive_grpc.Esive_SessionServer) error {
+	ctx := stream.Context()
+	playerID := ctx.Value("playerID").(string)
+	s.logger.Debug("Player opened a session", zap.String("playerID", playerID))
+	playerData := s.playerData(ctx)
+
+	delay := s.tick.Delay()
+	stream.Send(&esive_grpc.SessionRes{Event: &esive_grpc.SessionRes_TickSync{TickSync: &esive_grpc.TickSync{
+		Tick:             s.tick.Current(),
+		TickMilliseconds: int32(delay.Milliseconds()),
+	}}})
+	stream.Send(&esive_grpc.SessionRes{Event: &esive_grpc.SessionRes_TickUpdates{TickUpdates: &esive_grpc.TickUpdatesRes{
+		VisibilityUpdates: s.resyncUpdates(ctx, playerData),
+		Resync:            true,
+	}}})
+
+	backlog, err := s.chat.Backlog(ctx, playerData.Entity, config.Chat.Backlog)
+	if err != nil {
+		panic(err)
+	}
+	for _, message := range backlog {
+		stream.Send(&esive_grpc.SessionRes{Event: &esive_grpc.SessionRes_Chat{Chat: chatMessageFromSystem(message)}})
+	}
+
+	flushCh := s.addVisibilityFlush()
+	defer s.removeVisibilityFlush(flushCh)
+
+	// Only this goroutine sends on the stream. The commands are read in another one, and their acks come back here.
+	acks := make(chan *esive_grpc.SessionAck)
+	resyncs := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go s.sessionCommands(stream, playerData, acks, resyncs, done)
+
+	updates := make([]*esive_grpc.VisibilityUpdate, 0)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-playerData.kicked:
+			return nil
+		case <-flushCh:
+			if newDelay := s.tick.Delay(); newDelay != delay {
+				delay = newDelay
+				stream.Send(&esive_grpc.SessionRes{Event: &esive_grpc.SessionRes_TickSync{TickSync: &esive_grpc.TickSync{
+					Tick:             s.tick.Current(),
+					TickMilliseconds: int32(delay.Milliseconds()),
+				}}})
+			}
+			if len(updates) > 0 {
+				stream.Send(&esive_grpc.SessionRes{Event: &esive_grpc.SessionRes_TickUpdates{TickUpdates: &esive_grpc.TickUpdatesRes{
+					VisibilityUpdates: updates,
+				}}})
+				countVisibilityUpdates(updates)
+				updates = make([]*esive_grpc.VisibilityUpdate, 0)
+			}
+		case update := <-playerData.Updater.Updates:
+			updates = append(updates, update)
+		case message := <-playerData.Updater.Chats:
+			stream.Send(&esive_grpc.SessionRes{Event: &esive_grpc.SessionRes_Chat{Chat: message}})
+		case ack := <-acks:
+			stream.Send(&esive_grpc.SessionRes{Event: &esive_grpc.SessionRes_Ack{Ack: ack}})
+		case <-resyncs:
+			// The pending updates are based on what the client couldn't apply.
+			updates = make([]*esive_grpc.VisibilityUpdate, 0)
+			stream.Send(&esive_grpc.SessionRes{Event: &esive_grpc.SessionRes_TickUpdates{TickUpdates: &esive_grpc.TickUpdatesRes{
+				VisibilityUpdates: s.resyncUpdates(ctx, playerData),
+				Resync:            true,
+			}}})
+		}
+	}
+}
+
+// sessionCommands runs the commands of a session until the client stops sending them or the session ends.
+func (s *server) sessionCommands(stream esive_grpc.Esive_SessionServer, playerData *PlayerData, acks chan<- *esive_grpc.SessionAck, resyncs chan<- struct{}, done <-chan struct{}) {
+	ctx := stream.Context()
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return
+		}
+
+		ack := &esive_grpc.SessionAck{Id: req.Id}
+		if _, ok := req.Command.(*esive_grpc.SessionReq_Resync); ok {
+			select {
+			case resyncs <- struct{}{}:
+			case <-done:
+				return
+			}
+		} else if err := s.sessionCommand(ctx, playerData, req); err != nil {
+			ack.Error = err.Error()
+		}
+		ack.Tick = s.tick.Current()
+
+		select {
+		case acks <- ack:
+		case <-done:
+			return
+		}
+	}
+}
+
+func (s *server) sessionCommand(ctx context.Context, playerData *PlayerData, req *esive_grpc.SessionReq) error {
+	tick := req.Tick
+	if tick == 0 {
+		tick = s.tick.Current() + 1
+	}
+	if err := s.checkTick(tick); err != nil {
+		return err
+	}
+
+	switch command := req.Command.(type) {
+	case *esive_grpc.SessionReq_Velocity:
+		s.logger.Debug("Player set velocity", zap.Int64("entity_id", int64(playerData.Entity)), zap.Int64("vx", command.Velocity.X), zap.Int64("vy", command.Velocity.Y))
+		s.setVelocity(ctx, playerData, tick, command.Velocity)
+	case *esive_grpc.SessionReq_Say:
+		s.logger.Debug("Player say", zap.Int64("entity_id", int64(playerData.Entity)), zap.String("text", command.Say.Text))
+		if err := s.chat.Say(ctx, tick, playerData.Entity, command.Say.Text); err != nil {
+			panic(err)
+		}
+	case *esive_grpc.SessionReq_Read:
+		s.logger.Debug("Player read", zap.Int64("entity_id", int64(playerData.Entity)))
+		s.read(ctx, playerData, command.Read.Position)
+	default:
+		return errors.New("Unknown command")
+	}
+	return nil
+}
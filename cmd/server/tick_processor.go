@@ -3,11 +3,13 @@ package main
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/code-cell/esive/actions"
 	components "github.com/code-cell/esive/components"
 	"github.com/code-cell/esive/queue"
 	"github.com/code-cell/esive/systems"
+	"github.com/code-cell/esive/tick"
 	"github.com/nats-io/nats.go"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -16,19 +18,23 @@ import (
 type TickProcessor struct {
 	logger *zap.Logger
 	q      *queue.Queue
+	tick   *tick.Tick
 
 	actionsQueue *actions.ActionsQueue
 	movement     *systems.MovementSystem
 	vision       *systems.VisionSystem
+	notes        *systems.NoteSystem
 }
 
-func NewTickProcessor(logger *zap.Logger, q *queue.Queue, actionsQueue *actions.ActionsQueue, movement *systems.MovementSystem, vision *systems.VisionSystem) *TickProcessor {
+func NewTickProcessor(logger *zap.Logger, q *queue.Queue, t *tick.Tick, actionsQueue *actions.ActionsQueue, movement *systems.MovementSystem, vision *systems.VisionSystem, notes *systems.NoteSystem) *TickProcessor {
 	return &TickProcessor{
 		logger:       logger.With(zap.String("service", "tick_processor")),
 		q:            q,
+		tick:         t,
 		actionsQueue: actionsQueue,
 		movement:     movement,
 		vision:       vision,
+		notes:        notes,
 	}
 }
 
@@ -55,6 +61,7 @@ func (t *TickProcessor) Init() {
 
 	go t.q.Consume("tick", "actions", &queue.Tick{}, func(_ *nats.Msg, m proto.Message) {
 		tickMessage := m.(*queue.Tick)
+		start := time.Now()
 		t.actionsQueue.CallActions(tickMessage.Tick, context.Background())
 
 		chunks, err := t.movement.ChunksWithMovingEntities(context.Background())
@@ -72,10 +79,12 @@ func (t *TickProcessor) Init() {
 				y := y
 				wg.Add(1)
 				go func() {
+					requested := time.Now()
 					entities, err := t.q.ProcessChunkMovements(context.Background(), tickMessage.Tick, x, y)
 					if err != nil {
 						panic(err)
 					}
+					queueLatencyMetric.WithLabelValues("process-chunk-movements").Observe(time.Since(requested).Seconds())
 					acrossMtx.Lock()
 					across = append(across, entities...)
 					acrossMtx.Unlock()
@@ -87,7 +96,20 @@ func (t *TickProcessor) Init() {
 		if err := t.movement.MoveEntitiesAcrossChunks(context.Background(), across, tickMessage.Tick); err != nil {
 			panic(err)
 		}
+		if err := t.notes.Expire(context.Background()); err != nil {
+			panic(err)
+		}
+		if err := t.vision.Flush(context.Background(), tickMessage.Tick); err != nil {
+			panic(err)
+		}
 
 		t.q.HandleTickServicesDone(context.Background(), tickMessage.Tick)
+
+		took := time.Since(start)
+		tickDurationMetric.Observe(took.Seconds())
+		if took > t.tick.Delay() {
+			tickOverrunsMetric.Inc()
+			t.logger.Warn("tick took too long", zap.Int64("tick", tickMessage.Tick), zap.Duration("took", took))
+		}
 	})
 }
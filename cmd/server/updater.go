@@ -1,57 +1,183 @@
 package main
 
 import (
+	"sync"
+
 	components "github.com/code-cell/esive/components"
 	esive_grpc "github.com/code-cell/esive/grpc"
 	"github.com/code-cell/esive/systems"
+	"google.golang.org/protobuf/proto"
 )
 
 type updater struct {
 	Updates chan *esive_grpc.VisibilityUpdate
 	Chats   chan *esive_grpc.ChatMessage
+
+	// sent keeps the last state sent to the client for every renderable it knows about, so only the differences
+	// are sent afterwards. It's what was sent, not what the client acknowledged: updates go on a single gRPC stream,
+	// which delivers them in order or fails. Every new stream, and every resync asked for on a Session, starts over
+	// from the full state with Resync, so the client never applies a delta to a state it missed.
+	sentMtx sync.Mutex
+	sent    map[int64]*esive_grpc.Renderable
+
+	// closed makes the updates be dropped once nobody reads them anymore.
+	closed    chan struct{}
+	closeOnce sync.Once
 }
 
 func newUpdater() *updater {
 	res := &updater{
 		Updates: make(chan *esive_grpc.VisibilityUpdate),
 		Chats:   make(chan *esive_grpc.ChatMessage),
+		sent:    map[int64]*esive_grpc.Renderable{},
+		closed:  make(chan struct{}),
 	}
 	return res
 }
 
+// Close drops every following update, so systems don't block on a player that lost its connection.
+func (u *updater) Close() {
+	u.closeOnce.Do(func() {
+		close(u.closed)
+	})
+}
+
+func (u *updater) sendUpdate(update *esive_grpc.VisibilityUpdate) {
+	select {
+	case u.Updates <- update:
+	case <-u.closed:
+	}
+}
+
+// Resync returns the updates with the full state of the given items, and makes them the base for the following
+// updates.
+func (u *updater) Resync(items []*systems.VisionSystemLookItem) []*esive_grpc.VisibilityUpdate {
+	u.sentMtx.Lock()
+	defer u.sentMtx.Unlock()
+
+	u.sent = map[int64]*esive_grpc.Renderable{}
+	updates := make([]*esive_grpc.VisibilityUpdate, 0, len(items))
+	for _, item := range items {
+		renderable := renderableFromItem(item)
+		u.sent[item.ID] = renderable
+		updates = append(updates, &esive_grpc.VisibilityUpdate{
+			Action:     esive_grpc.VisibilityUpdate_ADD,
+			Renderable: renderable,
+		})
+	}
+	return updates
+}
+
 func (u *updater) HandleVisibilityLostSight(entity components.Entity, tick int64) {
-	u.Updates <- &esive_grpc.VisibilityUpdate{
+	u.sentMtx.Lock()
+	delete(u.sent, int64(entity))
+	u.sentMtx.Unlock()
+
+	u.sendUpdate(&esive_grpc.VisibilityUpdate{
 		Action: esive_grpc.VisibilityUpdate_REMOVE,
 		Tick:   tick,
 		Renderable: &esive_grpc.Renderable{
 			Id: int64(entity),
 		},
-	}
+	})
 
 }
+
+// HandleTickUpdate sends the renderable whole the first time, and only what changed since it was last sent
+// afterwards.
 func (u *updater) HandleTickUpdate(item *systems.VisionSystemLookItem, tick int64) {
-	u.Updates <- &esive_grpc.VisibilityUpdate{
-		Action: esive_grpc.VisibilityUpdate_ADD,
-		Tick:   tick,
-		Renderable: &esive_grpc.Renderable{
-			Char:  item.Char,
-			Color: item.Color,
-			Id:    item.ID,
-			Position: &esive_grpc.Position{
-				X: item.X,
-				Y: item.Y,
-			},
-			Velocity: &esive_grpc.Velocity{
-				X: item.VelX,
-				Y: item.VelY,
-			},
-		},
+	renderable := renderableFromItem(item)
+
+	u.sentMtx.Lock()
+	last, found := u.sent[item.ID]
+	u.sent[item.ID] = renderable
+	u.sentMtx.Unlock()
+
+	if !found {
+		u.sendUpdate(&esive_grpc.VisibilityUpdate{
+			Action:     esive_grpc.VisibilityUpdate_ADD,
+			Tick:       tick,
+			Renderable: renderable,
+		})
+		return
 	}
 
+	delta := &esive_grpc.RenderableDelta{Id: item.ID}
+	changed := false
+	if !proto.Equal(last.Position, renderable.Position) {
+		delta.Position = renderable.Position
+		changed = true
+	}
+	if !proto.Equal(last.Velocity, renderable.Velocity) {
+		delta.Velocity = renderable.Velocity
+		changed = true
+	}
+	if last.Char != renderable.Char || last.Color != renderable.Color || !proto.Equal(last.Light, renderable.Light) {
+		delta.Appearance = &esive_grpc.Appearance{
+			Char:  renderable.Char,
+			Color: renderable.Color,
+			Light: renderable.Light,
+		}
+		changed = true
+	}
+	if !changed {
+		return
+	}
+	u.sendUpdate(&esive_grpc.VisibilityUpdate{
+		Action: esive_grpc.VisibilityUpdate_UPDATE,
+		Tick:   tick,
+		Delta:  delta,
+	})
 }
 func (u *updater) HandleChatMessage(message *systems.ChatMessage) {
-	u.Chats <- &esive_grpc.ChatMessage{
-		From: message.FromName,
-		Text: message.Message,
+	select {
+	case u.Chats <- chatMessageFromSystem(message):
+	case <-u.closed:
+	}
+}
+
+var chatKinds = map[systems.ChatKind]esive_grpc.ChatMessage_Kind{
+	systems.ChatKindPlayer:  esive_grpc.ChatMessage_PLAYER,
+	systems.ChatKindSystem:  esive_grpc.ChatMessage_SYSTEM,
+	systems.ChatKindEmote:   esive_grpc.ChatMessage_EMOTE,
+	systems.ChatKindWhisper: esive_grpc.ChatMessage_WHISPER,
+	systems.ChatKindError:   esive_grpc.ChatMessage_ERROR,
+}
+
+func chatMessageFromSystem(message *systems.ChatMessage) *esive_grpc.ChatMessage {
+	return &esive_grpc.ChatMessage{
+		Kind:      chatKinds[message.Kind],
+		From:      message.FromName,
+		FromId:    int64(message.From),
+		Text:      message.Message,
+		Channel:   message.Channel,
+		To:        message.ToName,
+		Timestamp: message.Timestamp,
+		Tick:      message.Tick,
+		Id:        message.Id,
+	}
+}
+
+func renderableFromItem(item *systems.VisionSystemLookItem) *esive_grpc.Renderable {
+	var light *esive_grpc.Light
+	if item.LightRadius > 0 {
+		light = &esive_grpc.Light{
+			Radius: item.LightRadius,
+			Color:  item.LightColor,
+		}
+	}
+	return &esive_grpc.Renderable{
+		Char:  item.Char,
+		Color: item.Color,
+		Id:    item.ID,
+		Position: &esive_grpc.Position{
+			X: item.X,
+			Y: item.Y,
+		},
+		Velocity: &esive_grpc.Velocity{
+			X: item.VelX,
+			Y: item.VelY,
+		},
+		Light: light,
 	}
 }
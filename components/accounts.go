@@ -0,0 +1,112 @@
+package components
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var accountsTracer = otel.Tracer("components/accounts")
+
+var ErrWrongPassword = errors.New("Wrong password")
+
+// Accounts keeps the player accounts in redis, by name. Passwords are stored hashed.
+type Accounts struct {
+	store  *RedisStore
+	logger *zap.Logger
+}
+
+func NewAccounts(store *RedisStore, logger *zap.Logger) *Accounts {
+	return &Accounts{
+		store:  store,
+		logger: logger.With(zap.String("service", "accounts")),
+	}
+}
+
+// Login returns the account called `name` if the password is right, or ErrWrongPassword otherwise. Accounts are
+// created the first time someone logs in with their name.
+func (a *Accounts) Login(parentCtx context.Context, name, password string) (*Account, error) {
+	ctx, span := accountsTracer.Start(parentCtx, "Login")
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+	defer span.End()
+
+	account, found, err := a.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		account = &Account{Name: name, PasswordHash: hash}
+		created, err := a.store.HCreateProto(ctx, a.key(name), account)
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			a.logger.Info("created account", zap.String("name", name))
+			return account, nil
+		}
+		// Someone else created it first. The password has to match theirs.
+		account, found, err = a.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, errors.New("Unknown account")
+		}
+	}
+	if err := bcrypt.CompareHashAndPassword(account.PasswordHash, []byte(password)); err != nil {
+		return nil, ErrWrongPassword
+	}
+	return account, nil
+}
+
+// Get returns the account called `name`. It returns false if there isn't one.
+func (a *Accounts) Get(parentCtx context.Context, name string) (*Account, bool, error) {
+	ctx, span := accountsTracer.Start(parentCtx, "Get")
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+	defer span.End()
+
+	account := &Account{}
+	if err := a.store.HReadProtos(ctx, a.key(name), account); err != nil {
+		return nil, false, err
+	}
+	if account.Name == "" {
+		return nil, false, nil
+	}
+	return account, true, nil
+}
+
+// SetEntity makes `entity` the character of the account called `name`.
+func (a *Accounts) SetEntity(parentCtx context.Context, name string, entity Entity) error {
+	ctx, span := accountsTracer.Start(parentCtx, "SetEntity")
+	span.SetAttributes(
+		attribute.String("name", name),
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	account, found, err := a.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.New("Unknown account")
+	}
+	account.Entity = int64(entity)
+	return a.store.HSaveProto(ctx, a.key(name), account)
+}
+
+func (a *Accounts) key(name string) string {
+	return "account:" + name
+}
@@ -0,0 +1,69 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAccounts(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	rdb.FlushAll(context.Background())
+	accounts := NewAccounts(NewRedisStore(rdb, zap.NewNop()), zap.NewNop())
+
+	_, found, err := accounts.Get(context.Background(), "alice")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	account, err := accounts.Login(context.Background(), "alice", "secret")
+	require.NoError(t, err)
+	require.Equal(t, "alice", account.Name)
+	require.NotEqual(t, []byte("secret"), account.PasswordHash, "passwords are hashed")
+
+	_, err = accounts.Login(context.Background(), "alice", "wrong")
+	require.Equal(t, ErrWrongPassword, err)
+
+	require.NoError(t, accounts.SetEntity(context.Background(), "alice", 12))
+	account, err = accounts.Login(context.Background(), "alice", "secret")
+	require.NoError(t, err)
+	require.Equal(t, int64(12), account.Entity)
+}
+
+func TestAccounts_ConcurrentCreation(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	rdb.FlushAll(context.Background())
+	accounts := NewAccounts(NewRedisStore(rdb, zap.NewNop()), zap.NewNop())
+
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = accounts.Login(context.Background(), "alice", fmt.Sprintf("secret%d", i))
+		}(i)
+	}
+	wg.Wait()
+
+	winner := -1
+	for i, err := range errs {
+		if err == nil {
+			require.Equal(t, -1, winner, "only one password can create the account")
+			winner = i
+		} else {
+			require.Equal(t, ErrWrongPassword, err)
+		}
+	}
+	require.NotEqual(t, -1, winner)
+	_, err := accounts.Login(context.Background(), "alice", fmt.Sprintf("secret%d", winner))
+	require.NoError(t, err)
+}
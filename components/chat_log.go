@@ -0,0 +1,68 @@
+package components
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+var chatLogTracer = otel.Tracer("components/chat_log")
+
+// ChatLog keeps the last messages of every log in redis. Older messages are discarded.
+type ChatLog struct {
+	store  *RedisStore
+	size   int64
+	logger *zap.Logger
+}
+
+func NewChatLog(store *RedisStore, size int, logger *zap.Logger) *ChatLog {
+	return &ChatLog{
+		store:  store,
+		size:   int64(size),
+		logger: logger.With(zap.String("service", "chat_log")),
+	}
+}
+
+// NextID returns a new id for an entry. Ids increase with every call, so they order the entries.
+func (l *ChatLog) NextID(parentCtx context.Context) (int64, error) {
+	ctx, span := chatLogTracer.Start(parentCtx, "NextID")
+	defer span.End()
+
+	return l.store.NextInt64(ctx, "chat_log_id")
+}
+
+func (l *ChatLog) Append(parentCtx context.Context, log string, entry *ChatLogEntry) error {
+	ctx, span := chatLogTracer.Start(parentCtx, "Append")
+	span.SetAttributes(
+		attribute.String("log", log),
+	)
+	defer span.End()
+
+	return l.store.LPushProto(ctx, l.key(log), l.size, entry)
+}
+
+// Read returns the entries of a log, from the newest to the oldest.
+func (l *ChatLog) Read(parentCtx context.Context, log string) ([]*ChatLogEntry, error) {
+	ctx, span := chatLogTracer.Start(parentCtx, "Read")
+	span.SetAttributes(
+		attribute.String("log", log),
+	)
+	defer span.End()
+
+	values, err := l.store.LReadProtos(ctx, l.key(log), func() proto.Message { return &ChatLogEntry{} })
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*ChatLogEntry, len(values))
+	for i, v := range values {
+		entries[i] = v.(*ChatLogEntry)
+	}
+	return entries, nil
+}
+
+func (l *ChatLog) key(log string) string {
+	return "chat_log:" + log
+}
@@ -183,25 +183,693 @@ type Looker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// How far it sees, in units.
+	Radius float32 `protobuf:"fixed32,1,opt,name=radius,proto3" json:"radius,omitempty"`
+}
+
+func (x *Looker) Reset() {
+	*x = Looker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Looker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Looker) ProtoMessage() {}
+
+func (x *Looker) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Looker.ProtoReflect.Descriptor instead.
+func (*Looker) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Looker) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+type Speaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Range float32 `protobuf:"fixed32,1,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *Speaker) Reset() {
+	*x = Speaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Speaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Speaker) ProtoMessage() {}
+
+func (x *Speaker) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Speaker.ProtoReflect.Descriptor instead.
+func (*Speaker) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Speaker) GetRange() float32 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+type Render struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Char  string `protobuf:"bytes,1,opt,name=char,proto3" json:"char,omitempty"`
+	Color uint32 `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *Render) Reset() {
+	*x = Render{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Render) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Render) ProtoMessage() {}
+
+func (x *Render) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Render.ProtoReflect.Descriptor instead.
+func (*Render) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Render) GetChar() string {
+	if x != nil {
+		return x.Char
+	}
+	return ""
+}
+
+func (x *Render) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+type Readable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Readable) Reset() {
+	*x = Readable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Readable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Readable) ProtoMessage() {}
+
+func (x *Readable) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Readable.ProtoReflect.Descriptor instead.
+func (*Readable) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Readable) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Opaque entities block the sight of lookers.
+type Opaque struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Opaque) Reset() {
+	*x = Opaque{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Opaque) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Opaque) ProtoMessage() {}
+
+func (x *Opaque) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Opaque.ProtoReflect.Descriptor instead.
+func (*Opaque) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{7}
+}
+
+// Stealth hides an entity from the lookers whose perception is lower than its level.
+type Stealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *Stealth) Reset() {
+	*x = Stealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stealth) ProtoMessage() {}
+
+func (x *Stealth) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stealth.ProtoReflect.Descriptor instead.
+func (*Stealth) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Stealth) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// Perception lets a looker see stealthy entities up to its level.
+type Perception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *Perception) Reset() {
+	*x = Perception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Perception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Perception) ProtoMessage() {}
+
+func (x *Perception) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Perception.ProtoReflect.Descriptor instead.
+func (*Perception) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Perception) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// LightSource lights the tiles around it, so lookers can see them at night.
+type LightSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Radius float32 `protobuf:"fixed32,1,opt,name=radius,proto3" json:"radius,omitempty"`
+	Color  uint32  `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *LightSource) Reset() {
+	*x = LightSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LightSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LightSource) ProtoMessage() {}
+
+func (x *LightSource) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LightSource.ProtoReflect.Descriptor instead.
+func (*LightSource) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LightSource) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *LightSource) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+// ChatLogEntry isn't a component. It's a chat message stored in the chat log.
+type ChatLogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From     int64  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	FromName string `protobuf:"bytes,2,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	Message  string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Channel  string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	ToName   string `protobuf:"bytes,5,opt,name=to_name,json=toName,proto3" json:"to_name,omitempty"`
+	// Unix time in milliseconds.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// A systems.ChatKind.
+	Kind int32 `protobuf:"varint,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	Tick int64 `protobuf:"varint,8,opt,name=tick,proto3" json:"tick,omitempty"`
+	// The same for every log the message is in. It increases with every message.
+	Id int64 `protobuf:"varint,9,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ChatLogEntry) Reset() {
+	*x = ChatLogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatLogEntry) ProtoMessage() {}
+
+func (x *ChatLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatLogEntry.ProtoReflect.Descriptor instead.
+func (*ChatLogEntry) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChatLogEntry) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ChatLogEntry) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetToName() string {
+	if x != nil {
+		return x.ToName
+	}
+	return ""
+}
+
+func (x *ChatLogEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChatLogEntry) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *ChatLogEntry) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *ChatLogEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// BlockList has the names of the players whose messages aren't delivered to the entity.
+type BlockList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *BlockList) Reset() {
+	*x = BlockList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockList) ProtoMessage() {}
+
+func (x *BlockList) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockList.ProtoReflect.Descriptor instead.
+func (*BlockList) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BlockList) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// Role grants permissions to an entity. The level is a systems.Permission, players don't need one.
+type Role struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Role) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// Authored entities were created by a player, like notes.
+type Authored struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity int64  `protobuf:"varint,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Tick when it was created.
+	Tick int64 `protobuf:"varint,3,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *Authored) Reset() {
+	*x = Authored{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_components_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Authored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authored) ProtoMessage() {}
+
+func (x *Authored) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Looker) Reset() {
-	*x = Looker{}
+// Deprecated: Use Authored.ProtoReflect.Descriptor instead.
+func (*Authored) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Authored) GetEntity() int64 {
+	if x != nil {
+		return x.Entity
+	}
+	return 0
+}
+
+func (x *Authored) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Authored) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+// Expires entities are deleted at the given time. It isn't a tick, the tick starts again when the server restarts.
+type Expires struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix time in milliseconds.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Expires) Reset() {
+	*x = Expires{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_components_proto_msgTypes[3]
+		mi := &file_components_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Looker) String() string {
+func (x *Expires) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Looker) ProtoMessage() {}
+func (*Expires) ProtoMessage() {}
 
-func (x *Looker) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[3]
+func (x *Expires) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,36 +880,50 @@ func (x *Looker) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Looker.ProtoReflect.Descriptor instead.
-func (*Looker) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Expires.ProtoReflect.Descriptor instead.
+func (*Expires) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{15}
 }
 
-type Speaker struct {
+func (x *Expires) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// Mail isn't a component. It's a message kept in the mailbox of a player until they delete it.
+type Mail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Range float32 `protobuf:"fixed32,1,opt,name=range,proto3" json:"range,omitempty"`
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Text string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// Unix time in milliseconds.
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Read      bool  `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
 }
 
-func (x *Speaker) Reset() {
-	*x = Speaker{}
+func (x *Mail) Reset() {
+	*x = Mail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_components_proto_msgTypes[4]
+		mi := &file_components_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Speaker) String() string {
+func (x *Mail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Speaker) ProtoMessage() {}
+func (*Mail) ProtoMessage() {}
 
-func (x *Speaker) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[4]
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,44 +934,82 @@ func (x *Speaker) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Speaker.ProtoReflect.Descriptor instead.
-func (*Speaker) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Speaker) GetRange() float32 {
+func (x *Mail) GetId() int64 {
 	if x != nil {
-		return x.Range
+		return x.Id
 	}
 	return 0
 }
 
-type Render struct {
+func (x *Mail) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Mail) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Mail) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Mail) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Mail) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+// Account isn't a component. It's a player account, stored by name.
+type Account struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Char  string `protobuf:"bytes,1,opt,name=char,proto3" json:"char,omitempty"`
-	Color uint32 `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"`
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PasswordHash []byte `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	// The character of the account. 0 until it joins for the first time.
+	Entity int64 `protobuf:"varint,3,opt,name=entity,proto3" json:"entity,omitempty"`
 }
 
-func (x *Render) Reset() {
-	*x = Render{}
+func (x *Account) Reset() {
+	*x = Account{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_components_proto_msgTypes[5]
+		mi := &file_components_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Render) String() string {
+func (x *Account) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Render) ProtoMessage() {}
+func (*Account) ProtoMessage() {}
 
-func (x *Render) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[5]
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,50 +1020,63 @@ func (x *Render) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Render.ProtoReflect.Descriptor instead.
-func (*Render) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Render) GetChar() string {
+func (x *Account) GetName() string {
 	if x != nil {
-		return x.Char
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Render) GetColor() uint32 {
+func (x *Account) GetPasswordHash() []byte {
 	if x != nil {
-		return x.Color
+		return x.PasswordHash
+	}
+	return nil
+}
+
+func (x *Account) GetEntity() int64 {
+	if x != nil {
+		return x.Entity
 	}
 	return 0
 }
 
-type Readable struct {
+// Character keeps the components that place a player in the world while they are offline.
+type Character struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Position    *Position    `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Moveable    *Moveable    `protobuf:"bytes,2,opt,name=moveable,proto3" json:"moveable,omitempty"`
+	Render      *Render      `protobuf:"bytes,3,opt,name=render,proto3" json:"render,omitempty"`
+	Looker      *Looker      `protobuf:"bytes,4,opt,name=looker,proto3" json:"looker,omitempty"`
+	Speaker     *Speaker     `protobuf:"bytes,5,opt,name=speaker,proto3" json:"speaker,omitempty"`
+	LightSource *LightSource `protobuf:"bytes,6,opt,name=light_source,json=lightSource,proto3" json:"light_source,omitempty"`
 }
 
-func (x *Readable) Reset() {
-	*x = Readable{}
+func (x *Character) Reset() {
+	*x = Character{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_components_proto_msgTypes[6]
+		mi := &file_components_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Readable) String() string {
+func (x *Character) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Readable) ProtoMessage() {}
+func (*Character) ProtoMessage() {}
 
-func (x *Readable) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[6]
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,16 +1087,51 @@ func (x *Readable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Readable.ProtoReflect.Descriptor instead.
-func (*Readable) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Readable) GetText() string {
+func (x *Character) GetPosition() *Position {
 	if x != nil {
-		return x.Text
+		return x.Position
 	}
-	return ""
+	return nil
+}
+
+func (x *Character) GetMoveable() *Moveable {
+	if x != nil {
+		return x.Moveable
+	}
+	return nil
+}
+
+func (x *Character) GetRender() *Render {
+	if x != nil {
+		return x.Render
+	}
+	return nil
+}
+
+func (x *Character) GetLooker() *Looker {
+	if x != nil {
+		return x.Looker
+	}
+	return nil
+}
+
+func (x *Character) GetSpeaker() *Speaker {
+	if x != nil {
+		return x.Speaker
+	}
+	return nil
+}
+
+func (x *Character) GetLightSource() *LightSource {
+	if x != nil {
+		return x.LightSource
+	}
+	return nil
 }
 
 var File_components_proto protoreflect.FileDescriptor
@@ -378,18 +1146,87 @@ var file_components_proto_rawDesc = []byte{
 	0x03, 0x52, 0x04, 0x76, 0x65, 0x6c, 0x58, 0x12, 0x13, 0x0a, 0x05, 0x76, 0x65, 0x6c, 0x5f, 0x79,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x76, 0x65, 0x6c, 0x59, 0x22, 0x1b, 0x0a, 0x05,
 	0x4e, 0x61, 0x6d, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x08, 0x0a, 0x06, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x65, 0x72, 0x22, 0x1f, 0x0a, 0x07, 0x53, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x22, 0x32, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x68, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68,
-	0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x63, 0x65, 0x6c, 0x6c,
-	0x2f, 0x65, 0x73, 0x69, 0x76, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x06, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x22, 0x1f, 0x0a, 0x07, 0x53,
+	0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x32, 0x0a, 0x06,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x22, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0x08, 0x0a, 0x06, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x22, 0x1f, 0x0a, 0x07, 0x53, 0x74,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x22, 0x0a, 0x0a, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0x3b, 0x0a, 0x0b, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0xe2, 0x01, 0x0a,
+	0x0c, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63,
+	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x21, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0x4a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x27,
+	0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x80, 0x01, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x22, 0x5a, 0x0a, 0x07, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xb2, 0x02, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x08,
+	0x6d, 0x6f, 0x76, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x06, 0x72, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x65, 0x72,
+	0x12, 0x2d, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x53,
+	0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x73, 0x70, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12,
+	0x3a, 0x0a, 0x0c, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x63,
+	0x65, 0x6c, 0x6c, 0x2f, 0x65, 0x73, 0x69, 0x76, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -404,22 +1241,40 @@ func file_components_proto_rawDescGZIP() []byte {
 	return file_components_proto_rawDescData
 }
 
-var file_components_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_components_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_components_proto_goTypes = []interface{}{
-	(*Position)(nil), // 0: components.Position
-	(*Moveable)(nil), // 1: components.Moveable
-	(*Named)(nil),    // 2: components.Named
-	(*Looker)(nil),   // 3: components.Looker
-	(*Speaker)(nil),  // 4: components.Speaker
-	(*Render)(nil),   // 5: components.Render
-	(*Readable)(nil), // 6: components.Readable
+	(*Position)(nil),     // 0: components.Position
+	(*Moveable)(nil),     // 1: components.Moveable
+	(*Named)(nil),        // 2: components.Named
+	(*Looker)(nil),       // 3: components.Looker
+	(*Speaker)(nil),      // 4: components.Speaker
+	(*Render)(nil),       // 5: components.Render
+	(*Readable)(nil),     // 6: components.Readable
+	(*Opaque)(nil),       // 7: components.Opaque
+	(*Stealth)(nil),      // 8: components.Stealth
+	(*Perception)(nil),   // 9: components.Perception
+	(*LightSource)(nil),  // 10: components.LightSource
+	(*ChatLogEntry)(nil), // 11: components.ChatLogEntry
+	(*BlockList)(nil),    // 12: components.BlockList
+	(*Role)(nil),         // 13: components.Role
+	(*Authored)(nil),     // 14: components.Authored
+	(*Expires)(nil),      // 15: components.Expires
+	(*Mail)(nil),         // 16: components.Mail
+	(*Account)(nil),      // 17: components.Account
+	(*Character)(nil),    // 18: components.Character
 }
 var file_components_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: components.Character.position:type_name -> components.Position
+	1,  // 1: components.Character.moveable:type_name -> components.Moveable
+	5,  // 2: components.Character.render:type_name -> components.Render
+	3,  // 3: components.Character.looker:type_name -> components.Looker
+	4,  // 4: components.Character.speaker:type_name -> components.Speaker
+	10, // 5: components.Character.light_source:type_name -> components.LightSource
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_components_proto_init() }
@@ -512,6 +1367,150 @@ func file_components_proto_init() {
 				return nil
 			}
 		}
+		file_components_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Opaque); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Perception); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authored); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Expires); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_components_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Character); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -519,7 +1518,7 @@ func file_components_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_components_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -81,6 +81,24 @@ func TestRegistryLoadComponents(t *testing.T) {
 	require.Equal(t, []Entity{entityWithPositionAndRender}, ids)
 }
 
+func TestRegistryComponents(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	redisStore := NewRedisStore(rdb, zap.NewNop())
+	registry := NewRegistry(redisStore, zap.NewNop())
+
+	entity, err := registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, registry.CreateComponents(context.Background(), entity, &Position{X: 1, Y: 2}, &Opaque{}))
+
+	components, err := registry.Components(context.Background(), entity)
+	require.NoError(t, err)
+	require.Len(t, components, 2)
+	require.True(t, proto.Equal(&Position{X: 1, Y: 2}, components[0]))
+	require.True(t, proto.Equal(&Opaque{}, components[1]))
+}
+
 func TestRegistryCreateCallback(t *testing.T) {
 	rdb := redis.NewClient(&redis.Options{
 		Addr: "localhost:6379",
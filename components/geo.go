@@ -16,6 +16,12 @@ import (
 
 var geoTracer = otel.Tracer("geo")
 
+// Chunk identifies a square area of the world of `chunkSize` units per side.
+type Chunk struct {
+	X int64
+	Y int64
+}
+
 type Geo struct {
 	registry  *Registry
 	store     *RedisStore
@@ -156,8 +162,6 @@ func (g *Geo) FindInRange(parentCtx context.Context, x, y int64, rng float32, ex
 	defer span.End()
 
 	logger.Debug("finding entities in range")
-	chunksInRange := int64(math.Ceil(float64(rng) / float64(g.chunkSize)))
-	originChunkX, originChunkY := g.Chunk(x, y)
 
 	entities := []Entity{}
 	positions := []*Position{}
@@ -168,31 +172,28 @@ func (g *Geo) FindInRange(parentCtx context.Context, x, y int64, rng float32, ex
 	mtx := &sync.Mutex{}
 	errGr := &errgroup.Group{}
 
-	for chunkX := originChunkX - chunksInRange; chunkX <= originChunkX+chunksInRange; chunkX++ {
-		for chunkY := originChunkY - chunksInRange; chunkY <= originChunkY+chunksInRange; chunkY++ {
-			chunkX := chunkX
-			chunkY := chunkY
-			errGr.Go(func() error {
-				logger.Debug("checking chunk", zap.Int64("chunkX", chunkX), zap.Int64("chunkY", chunkY))
-
-				e, c, err := g.registry.LoadComponentsFromIndex(ctx, g.key(chunkX, chunkY), queryComponents...)
-				if err != nil {
-					logger.Error("error finding chunk members", zap.Error(err))
-					return err
-				}
-				mtx.Lock()
-				for i, entity := range e {
-					pos := c[i][0].(*Position)
-					if Distance(x, y, pos.X, pos.Y) <= rng {
-						entities = append(entities, entity)
-						positions = append(positions, pos)
-						extras = append(extras, c[i][1:])
-					}
+	for _, chunk := range g.ChunksInRange(x, y, rng) {
+		chunk := chunk
+		errGr.Go(func() error {
+			logger.Debug("checking chunk", zap.Int64("chunkX", chunk.X), zap.Int64("chunkY", chunk.Y))
+
+			e, c, err := g.registry.LoadComponentsFromIndex(ctx, g.key(chunk.X, chunk.Y), queryComponents...)
+			if err != nil {
+				logger.Error("error finding chunk members", zap.Error(err))
+				return err
+			}
+			mtx.Lock()
+			for i, entity := range e {
+				pos := c[i][0].(*Position)
+				if Distance(x, y, pos.X, pos.Y) <= rng {
+					entities = append(entities, entity)
+					positions = append(positions, pos)
+					extras = append(extras, c[i][1:])
 				}
-				mtx.Unlock()
-				return nil
-			})
-		}
+			}
+			mtx.Unlock()
+			return nil
+		})
 	}
 	if err := errGr.Wait(); err != nil {
 		return nil, nil, nil, err
@@ -204,6 +205,46 @@ func (g *Geo) Chunk(x, y int64) (int64, int64) {
 	return x / int64(g.chunkSize), y / int64(g.chunkSize)
 }
 
+// ChunksInRange returns all the chunks that may contain entities within `rng` units of [x, y].
+func (g *Geo) ChunksInRange(x, y int64, rng float32) []Chunk {
+	chunksInRange := int64(math.Ceil(float64(rng) / float64(g.chunkSize)))
+	originChunkX, originChunkY := g.Chunk(x, y)
+
+	res := make([]Chunk, 0, (2*chunksInRange+1)*(2*chunksInRange+1))
+	for chunkX := originChunkX - chunksInRange; chunkX <= originChunkX+chunksInRange; chunkX++ {
+		for chunkY := originChunkY - chunksInRange; chunkY <= originChunkY+chunksInRange; chunkY++ {
+			res = append(res, Chunk{X: chunkX, Y: chunkY})
+		}
+	}
+	return res
+}
+
+// ChunkEntities returns how many entities there are in every chunk that isn't empty.
+func (g *Geo) ChunkEntities(parentCtx context.Context) (map[Chunk]int64, error) {
+	ctx, span := geoTracer.Start(parentCtx, "ChunkEntities")
+	defer span.End()
+
+	keys, err := g.store.Keys(ctx, "chunks:*")
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[Chunk]int64, len(keys))
+	for _, key := range keys {
+		chunk := Chunk{}
+		if _, err := fmt.Sscanf(key, "chunks:%d:%d", &chunk.X, &chunk.Y); err != nil {
+			continue
+		}
+		count, err := g.store.SCard(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			res[chunk] = count
+		}
+	}
+	return res, nil
+}
+
 func (g *Geo) key(chunkX, chunkY int64) string {
 	return fmt.Sprintf("chunks:%d:%d", chunkX, chunkY)
 }
@@ -56,6 +56,30 @@ func TestGeo(t *testing.T) {
 	require.ElementsMatch(t, validEntities, found)
 }
 
+func TestGeo_ChunkEntities(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	rdb.FlushAll(context.Background())
+	redisStore := components.NewRedisStore(rdb, zap.NewNop())
+	registry := components.NewRegistry(redisStore, zap.NewNop())
+	geo := components.NewGeo(registry, redisStore, 10, zap.NewNop())
+
+	for _, pos := range []*components.Position{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: -20, Y: 5}} {
+		e, err := registry.NewEntity(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, registry.CreateComponents(context.Background(), e, pos))
+	}
+
+	counts, err := geo.ChunkEntities(context.Background())
+	require.NoError(t, err)
+	chunkX, chunkY := geo.Chunk(-20, 5)
+	require.Equal(t, map[components.Chunk]int64{
+		{X: 0, Y: 0}:           2,
+		{X: chunkX, Y: chunkY}: 1,
+	}, counts)
+}
+
 // func TestGeo_HandleUpdates(t *testing.T) {
 // 	rdb := redis.NewClient(&redis.Options{
 // 		Addr: "localhost:6379",
@@ -0,0 +1,150 @@
+package components
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+var mailboxTracer = otel.Tracer("components/mailbox")
+
+var (
+	ErrUnknownMailbox = errors.New("Unknown mailbox")
+	ErrMailboxFull    = errors.New("Mailbox full")
+)
+
+// Mailbox keeps the mail of every player in redis, by name, so it's delivered even if they are offline. Only the
+// players that have a mailbox can get mail.
+type Mailbox struct {
+	store  *RedisStore
+	size   int
+	logger *zap.Logger
+}
+
+func NewMailbox(store *RedisStore, size int, logger *zap.Logger) *Mailbox {
+	return &Mailbox{
+		store:  store,
+		size:   size,
+		logger: logger.With(zap.String("service", "mailbox")),
+	}
+}
+
+// Open creates the mailbox of a player, if it doesn't exist yet.
+func (m *Mailbox) Open(parentCtx context.Context, name string) error {
+	ctx, span := mailboxTracer.Start(parentCtx, "Open")
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+	defer span.End()
+
+	_, err := m.store.SAdd(ctx, "mailboxes", name)
+	return err
+}
+
+// Send stores the mail in the mailbox of `mail.To`, assigning it an id. It returns ErrUnknownMailbox if the
+// player doesn't have one, and ErrMailboxFull if it has no room for more mail.
+func (m *Mailbox) Send(parentCtx context.Context, mail *Mail) error {
+	ctx, span := mailboxTracer.Start(parentCtx, "Send")
+	span.SetAttributes(
+		attribute.String("to", mail.To),
+	)
+	defer span.End()
+
+	found, err := m.store.SIsMember(ctx, "mailboxes", mail.To)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrUnknownMailbox
+	}
+
+	id, err := m.store.NextInt64(ctx, "mail_id")
+	if err != nil {
+		return err
+	}
+	mail.Id = id
+	if m.size <= 0 {
+		return m.store.HSetProtoField(ctx, m.key(mail.To), strconv.FormatInt(id, 10), mail)
+	}
+	// The size is checked in the same step the mail is saved, so concurrent sends can't go past it.
+	saved, err := m.store.HSetProtoFieldCapped(ctx, m.key(mail.To), strconv.FormatInt(id, 10), int64(m.size), mail)
+	if err != nil {
+		return err
+	}
+	if !saved {
+		return ErrMailboxFull
+	}
+	return nil
+}
+
+// List returns the mail of a player, from the oldest to the newest.
+func (m *Mailbox) List(parentCtx context.Context, name string) ([]*Mail, error) {
+	ctx, span := mailboxTracer.Start(parentCtx, "List")
+	span.SetAttributes(
+		attribute.String("name", name),
+	)
+	defer span.End()
+
+	values, err := m.store.HReadAllProtos(ctx, m.key(name), func() proto.Message { return &Mail{} })
+	if err != nil {
+		return nil, err
+	}
+	mails := make([]*Mail, 0, len(values))
+	for _, v := range values {
+		mails = append(mails, v.(*Mail))
+	}
+	sort.Slice(mails, func(i, j int) bool {
+		return mails[i].Id < mails[j].Id
+	})
+	return mails, nil
+}
+
+// Read returns a mail of a player, and marks it as read. It returns false if there is no mail with that id.
+func (m *Mailbox) Read(parentCtx context.Context, name string, id int64) (*Mail, bool, error) {
+	ctx, span := mailboxTracer.Start(parentCtx, "Read")
+	span.SetAttributes(
+		attribute.String("name", name),
+		attribute.Int64("id", id),
+	)
+	defer span.End()
+
+	mails, err := m.List(ctx, name)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, mail := range mails {
+		if mail.Id != id {
+			continue
+		}
+		if !mail.Read {
+			mail.Read = true
+			if err := m.store.HSetProtoField(ctx, m.key(name), strconv.FormatInt(id, 10), mail); err != nil {
+				return nil, false, err
+			}
+		}
+		return mail, true, nil
+	}
+	return nil, false, nil
+}
+
+// Delete removes a mail of a player. It returns false if there is no mail with that id.
+func (m *Mailbox) Delete(parentCtx context.Context, name string, id int64) (bool, error) {
+	ctx, span := mailboxTracer.Start(parentCtx, "Delete")
+	span.SetAttributes(
+		attribute.String("name", name),
+		attribute.Int64("id", id),
+	)
+	defer span.End()
+
+	return m.store.HDel(ctx, m.key(name), strconv.FormatInt(id, 10))
+}
+
+func (m *Mailbox) key(name string) string {
+	return "mailbox:" + name
+}
@@ -0,0 +1,84 @@
+package components
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMailbox(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	rdb.FlushAll(context.Background())
+	mailbox := NewMailbox(NewRedisStore(rdb, zap.NewNop()), 2, zap.NewNop())
+
+	require.Equal(t, ErrUnknownMailbox, mailbox.Send(context.Background(), &Mail{From: "alice", To: "bob", Text: "hi"}))
+
+	require.NoError(t, mailbox.Open(context.Background(), "bob"))
+	require.NoError(t, mailbox.Send(context.Background(), &Mail{From: "alice", To: "bob", Text: "hi"}))
+	require.NoError(t, mailbox.Send(context.Background(), &Mail{From: "carol", To: "bob", Text: "hello"}))
+	require.Equal(t, ErrMailboxFull, mailbox.Send(context.Background(), &Mail{From: "carol", To: "bob", Text: "again"}))
+
+	mails, err := mailbox.List(context.Background(), "bob")
+	require.NoError(t, err)
+	require.Len(t, mails, 2)
+	require.Equal(t, "hi", mails[0].Text)
+	require.Equal(t, "hello", mails[1].Text)
+	require.False(t, mails[0].Read)
+
+	mail, found, err := mailbox.Read(context.Background(), "bob", mails[0].Id)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, mail.Read)
+
+	found, err = mailbox.Delete(context.Background(), "bob", mails[1].Id)
+	require.NoError(t, err)
+	require.True(t, found)
+	found, err = mailbox.Delete(context.Background(), "bob", mails[1].Id)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	mails, err = mailbox.List(context.Background(), "bob")
+	require.NoError(t, err)
+	require.Len(t, mails, 1)
+	require.True(t, mails[0].Read)
+}
+
+func TestMailbox_ConcurrentSends(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	rdb.FlushAll(context.Background())
+	mailbox := NewMailbox(NewRedisStore(rdb, zap.NewNop()), 5, zap.NewNop())
+	require.NoError(t, mailbox.Open(context.Background(), "bob"))
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- mailbox.Send(context.Background(), &Mail{From: "alice", To: "bob", Text: "hi"})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	full := 0
+	for err := range errs {
+		if err == ErrMailboxFull {
+			full++
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	require.Equal(t, 15, full)
+
+	mails, err := mailbox.List(context.Background(), "bob")
+	require.NoError(t, err)
+	require.Len(t, mails, 5)
+}
@@ -0,0 +1,107 @@
+package components
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+var noteIndexTracer = otel.Tracer("components/note_index")
+
+// NoteIndex keeps in redis the notes of every author, and the time every note expires at, so they don't have to be
+// searched among all the entities.
+type NoteIndex struct {
+	store  *RedisStore
+	logger *zap.Logger
+}
+
+func NewNoteIndex(store *RedisStore, logger *zap.Logger) *NoteIndex {
+	return &NoteIndex{
+		store:  store,
+		logger: logger.With(zap.String("service", "note_index")),
+	}
+}
+
+// Add indexes a note of `author`. `expires` is the unix time in milliseconds it expires at, or 0 if it doesn't.
+func (i *NoteIndex) Add(parentCtx context.Context, note Entity, author string, expires int64) error {
+	ctx, span := noteIndexTracer.Start(parentCtx, "Add")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(note)),
+		attribute.String("author", author),
+	)
+	defer span.End()
+
+	id := strconv.FormatInt(int64(note), 10)
+	if _, err := i.store.SAdd(ctx, i.authorKey(author), id); err != nil {
+		return err
+	}
+	if expires > 0 {
+		return i.store.ZAdd(ctx, "notes:expiries", expires, id)
+	}
+	return nil
+}
+
+// Remove takes a note of `author` out of the index.
+func (i *NoteIndex) Remove(parentCtx context.Context, note Entity, author string) error {
+	ctx, span := noteIndexTracer.Start(parentCtx, "Remove")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(note)),
+		attribute.String("author", author),
+	)
+	defer span.End()
+
+	id := strconv.FormatInt(int64(note), 10)
+	if err := i.store.SRem(ctx, i.authorKey(author), id); err != nil {
+		return err
+	}
+	return i.store.ZRem(ctx, "notes:expiries", id)
+}
+
+// ByAuthor returns the notes of `author`.
+func (i *NoteIndex) ByAuthor(parentCtx context.Context, author string) ([]Entity, error) {
+	ctx, span := noteIndexTracer.Start(parentCtx, "ByAuthor")
+	span.SetAttributes(
+		attribute.String("author", author),
+	)
+	defer span.End()
+
+	ids, err := i.store.SMembers(ctx, i.authorKey(author))
+	if err != nil {
+		return nil, err
+	}
+	return parseEntities(ids)
+}
+
+// Expired returns the notes that expire at `timestamp` (unix time in milliseconds) or before.
+func (i *NoteIndex) Expired(parentCtx context.Context, timestamp int64) ([]Entity, error) {
+	ctx, span := noteIndexTracer.Start(parentCtx, "Expired")
+	span.SetAttributes(
+		attribute.Int64("timestamp", timestamp),
+	)
+	defer span.End()
+
+	ids, err := i.store.ZRangeUpTo(ctx, "notes:expiries", timestamp)
+	if err != nil {
+		return nil, err
+	}
+	return parseEntities(ids)
+}
+
+func (i *NoteIndex) authorKey(author string) string {
+	return "notes:author:" + author
+}
+
+func parseEntities(ids []string) ([]Entity, error) {
+	res := make([]Entity, 0, len(ids))
+	for _, id := range ids {
+		entity, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, Entity(entity))
+	}
+	return res, nil
+}
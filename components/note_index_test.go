@@ -0,0 +1,41 @@
+package components
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNoteIndex(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	rdb.FlushAll(context.Background())
+	index := NewNoteIndex(NewRedisStore(rdb, zap.NewNop()), zap.NewNop())
+
+	require.NoError(t, index.Add(context.Background(), 1, "alice", 20))
+	require.NoError(t, index.Add(context.Background(), 2, "alice", 10))
+	require.NoError(t, index.Add(context.Background(), 3, "bob", 0))
+
+	notes, err := index.ByAuthor(context.Background(), "alice")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []Entity{1, 2}, notes)
+
+	expired, err := index.Expired(context.Background(), 15)
+	require.NoError(t, err)
+	require.Equal(t, []Entity{2}, expired)
+	expired, err = index.Expired(context.Background(), 1000)
+	require.NoError(t, err)
+	require.Equal(t, []Entity{2, 1}, expired, "notes without expiry aren't indexed by it")
+
+	require.NoError(t, index.Remove(context.Background(), 2, "alice"))
+	notes, err = index.ByAuthor(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Equal(t, []Entity{1}, notes)
+	expired, err = index.Expired(context.Background(), 15)
+	require.NoError(t, err)
+	require.Empty(t, expired)
+}
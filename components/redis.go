@@ -54,6 +54,82 @@ func (s *RedisStore) SRem(ctx context.Context, key, value string) error {
 	return nil
 }
 
+// SMembers returns the members of a set
+func (s *RedisStore) SMembers(ctx context.Context, key string) ([]string, error) {
+	res := s.client.SMembers(ctx, key)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error listing members of set", zap.Error(err), zap.String("key", key))
+		return nil, err
+	}
+	return res.Val(), nil
+}
+
+// ZAdd adds a member to a sorted set, or changes its score if it's already in
+func (s *RedisStore) ZAdd(ctx context.Context, key string, score int64, value string) error {
+	res := s.client.ZAdd(ctx, key, &redis.Z{Score: float64(score), Member: value})
+	if err := res.Err(); err != nil {
+		s.logger.Error("error adding member to sorted set", zap.Error(err), zap.String("key", key), zap.String("value", value))
+		return err
+	}
+	s.logger.Debug("added member to sorted set", zap.String("key", key), zap.String("value", value), zap.Int64("score", score))
+	return nil
+}
+
+// ZRangeUpTo returns the members of a sorted set with a score up to `max`, from the lowest score
+func (s *RedisStore) ZRangeUpTo(ctx context.Context, key string, max int64) ([]string, error) {
+	res := s.client.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: "-inf", Max: strconv.FormatInt(max, 10)})
+	if err := res.Err(); err != nil {
+		s.logger.Error("error getting range of sorted set", zap.Error(err), zap.String("key", key), zap.Int64("max", max))
+		return nil, err
+	}
+	return res.Val(), nil
+}
+
+// ZRem removes a member from a sorted set
+func (s *RedisStore) ZRem(ctx context.Context, key, value string) error {
+	res := s.client.ZRem(ctx, key, value)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error removing member from sorted set", zap.Error(err), zap.String("key", key), zap.String("value", value))
+		return err
+	}
+	s.logger.Debug("removed member from sorted set", zap.String("key", key), zap.String("value", value))
+	return nil
+}
+
+// SCard returns how many members a set has
+func (s *RedisStore) SCard(ctx context.Context, key string) (int64, error) {
+	res := s.client.SCard(ctx, key)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error counting members of set", zap.Error(err), zap.String("key", key))
+		return 0, err
+	}
+	return res.Val(), nil
+}
+
+// Keys returns every key that matches the pattern. It iterates with SCAN, so it doesn't block redis.
+func (s *RedisStore) Keys(ctx context.Context, pattern string) ([]string, error) {
+	keys := []string{}
+	iter := s.client.Scan(ctx, 0, pattern, 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		s.logger.Error("error scanning keys", zap.Error(err), zap.String("pattern", pattern))
+		return nil, err
+	}
+	return keys, nil
+}
+
+// SIsMember checks if a value is a member of a set
+func (s *RedisStore) SIsMember(ctx context.Context, key, value string) (bool, error) {
+	res := s.client.SIsMember(ctx, key, value)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error checking member of set", zap.Error(err), zap.String("key", key), zap.String("value", value))
+		return false, err
+	}
+	return res.Val(), nil
+}
+
 // HSaveProto saves a protocol buffers object into a hash, using the type of the object as a key within the hash.
 func (s *RedisStore) HSaveProto(ctx context.Context, key string, values ...proto.Message) error {
 	args := []interface{}{}
@@ -75,6 +151,34 @@ func (s *RedisStore) HSaveProto(ctx context.Context, key string, values ...proto
 	return nil
 }
 
+// HCreateProto saves a protocol buffers object into a hash, using the type of the object as a key within the hash,
+// unless there is one already. It returns whether it was saved.
+func (s *RedisStore) HCreateProto(ctx context.Context, key string, v proto.Message) (bool, error) {
+	name := string(v.ProtoReflect().Descriptor().FullName().Name())
+	out, err := proto.Marshal(v)
+	if err != nil {
+		s.logger.Error("error marshalling proto", zap.Error(err), zap.String("key", key), zap.String("name", name))
+		return false, err
+	}
+	res := s.client.HSetNX(ctx, key, name, out)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error setting value in hash", zap.Error(err), zap.String("key", key))
+		return false, err
+	}
+	s.logger.Debug("created proto", zap.String("key", key), zap.Bool("created", res.Val()))
+	return res.Val(), nil
+}
+
+// HKeys returns the names of the fields in a hash
+func (s *RedisStore) HKeys(ctx context.Context, key string) ([]string, error) {
+	res := s.client.HKeys(ctx, key)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error listing hash fields", zap.Error(err), zap.String("key", key))
+		return nil, err
+	}
+	return res.Val(), nil
+}
+
 // HDelProto deletes a protocol buffers object from a hash, using the type of the object as a key within the hash.
 func (s *RedisStore) HDelProto(ctx context.Context, key string, v proto.Message) error {
 	name := string(v.ProtoReflect().Descriptor().FullName().Name())
@@ -113,6 +217,117 @@ func (s *RedisStore) HReadProtos(ctx context.Context, key string, values ...prot
 	return nil
 }
 
+// HSetProtoField saves a protocol buffers object into a hash, using `field` as the key within the hash.
+func (s *RedisStore) HSetProtoField(ctx context.Context, key, field string, v proto.Message) error {
+	out, err := proto.Marshal(v)
+	if err != nil {
+		s.logger.Error("error marshalling proto", zap.Error(err), zap.String("key", key), zap.String("field", field))
+		return err
+	}
+	res := s.client.HSet(ctx, key, field, out)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error setting value in hash", zap.Error(err), zap.String("key", key), zap.String("field", field))
+		return err
+	}
+	s.logger.Debug("saved proto", zap.String("key", key), zap.String("field", field))
+	return nil
+}
+
+// hSetCapped sets a field only if the hash has less than ARGV[1] fields, in one step.
+var hSetCapped = redis.NewScript(`
+if redis.call("HLEN", KEYS[1]) >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call("HSET", KEYS[1], ARGV[2], ARGV[3])
+return 1
+`)
+
+// HSetProtoFieldCapped is like HSetProtoField, but only saves the object if the hash has less than `max` fields. It
+// returns false if it didn't.
+func (s *RedisStore) HSetProtoFieldCapped(ctx context.Context, key, field string, max int64, v proto.Message) (bool, error) {
+	out, err := proto.Marshal(v)
+	if err != nil {
+		s.logger.Error("error marshalling proto", zap.Error(err), zap.String("key", key), zap.String("field", field))
+		return false, err
+	}
+	res, err := hSetCapped.Run(ctx, s.client, []string{key}, max, field, out).Int()
+	if err != nil {
+		s.logger.Error("error setting value in hash", zap.Error(err), zap.String("key", key), zap.String("field", field))
+		return false, err
+	}
+	s.logger.Debug("saved proto", zap.String("key", key), zap.String("field", field), zap.Bool("saved", res == 1))
+	return res == 1, nil
+}
+
+// HReadAllProtos reads all the protocol buffers objects in a hash, by field. `newFn` creates the objects to
+// unmarshal into.
+func (s *RedisStore) HReadAllProtos(ctx context.Context, key string, newFn func() proto.Message) (map[string]proto.Message, error) {
+	res := s.client.HGetAll(ctx, key)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error reading hash", zap.Error(err), zap.String("key", key))
+		return nil, err
+	}
+	values := make(map[string]proto.Message, len(res.Val()))
+	for field, item := range res.Val() {
+		v := newFn()
+		if err := proto.Unmarshal([]byte(item), v); err != nil {
+			s.logger.Error("error unmarshalling protos", zap.Error(err), zap.String("key", key), zap.String("field", field))
+			return nil, err
+		}
+		values[field] = v
+	}
+	return values, nil
+}
+
+// HDel deletes a field from a hash. It returns false if it didn't exist.
+func (s *RedisStore) HDel(ctx context.Context, key, field string) (bool, error) {
+	res := s.client.HDel(ctx, key, field)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error deleting hash member", zap.Error(err), zap.String("key", key), zap.String("field", field))
+		return false, err
+	}
+	s.logger.Debug("deleted hash member", zap.String("key", key), zap.String("field", field))
+	return res.Val() == 1, nil
+}
+
+// LPushProto prepends a protocol buffers object to a list, and trims the list to its first `max` elements.
+func (s *RedisStore) LPushProto(ctx context.Context, key string, max int64, v proto.Message) error {
+	out, err := proto.Marshal(v)
+	if err != nil {
+		s.logger.Error("error marshalling proto", zap.Error(err), zap.String("key", key))
+		return err
+	}
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, key, out)
+		pipe.LTrim(ctx, key, 0, max-1)
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("error pushing to list", zap.Error(err), zap.String("key", key))
+		return err
+	}
+	s.logger.Debug("pushed proto", zap.String("key", key))
+	return nil
+}
+
+// LReadProtos reads all the protocol buffers objects in a list. `newFn` creates the objects to unmarshal into.
+func (s *RedisStore) LReadProtos(ctx context.Context, key string, newFn func() proto.Message) ([]proto.Message, error) {
+	res := s.client.LRange(ctx, key, 0, -1)
+	if err := res.Err(); err != nil {
+		s.logger.Error("error reading list", zap.Error(err), zap.String("key", key))
+		return nil, err
+	}
+	values := make([]proto.Message, len(res.Val()))
+	for i, item := range res.Val() {
+		values[i] = newFn()
+		if err := proto.Unmarshal([]byte(item), values[i]); err != nil {
+			s.logger.Error("error unmarshalling protos", zap.Error(err), zap.String("key", key))
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
 // Del deletes a key from redis.
 func (s *RedisStore) Del(ctx context.Context, key string) error {
 	res := s.client.Del(ctx, key)
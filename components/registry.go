@@ -144,7 +144,7 @@ func (b *Registry) DeleteEntity(parentCtx context.Context, entity Entity) error
 	logger.Debug("deleting entity")
 	idStr := strconv.FormatInt(int64(entity), 10)
 
-	allComponents := []proto.Message{&Position{}, &Render{}, &Looker{}, &Named{}, &Speaker{}, &Moveable{}, &Readable{}}
+	allComponents := allComponentTypes()
 	err := b.LoadComponents(ctx, entity, allComponents...)
 	if err != nil {
 		logger.Error("error loading components", zap.Error(err))
@@ -166,6 +166,36 @@ func (b *Registry) DeleteEntity(parentCtx context.Context, entity Entity) error
 	return nil
 }
 
+// Components returns every component the entity has.
+func (b *Registry) Components(parentCtx context.Context, entity Entity) ([]proto.Message, error) {
+	logger := b.logger.With(zap.Int64("entity_id", int64(entity)))
+	ctx, span := registryTracer.Start(parentCtx, "Components")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	names, err := b.redisStore.HKeys(ctx, strconv.FormatInt(int64(entity), 10))
+	if err != nil {
+		logger.Error("error listing components", zap.Error(err))
+		return nil, err
+	}
+	found := map[string]bool{}
+	for _, name := range names {
+		found[name] = true
+	}
+	components := []proto.Message{}
+	for _, component := range allComponentTypes() {
+		if found[string(component.ProtoReflect().Descriptor().FullName().Name())] {
+			components = append(components, component)
+		}
+	}
+	if len(components) == 0 {
+		return components, nil
+	}
+	return components, b.LoadComponents(ctx, entity, components...)
+}
+
 func (b *Registry) LoadComponents(parentCtx context.Context, entity Entity, components ...proto.Message) error {
 	componentTypes := make([]string, len(components))
 	for i, component := range components {
@@ -219,6 +249,11 @@ func (b *Registry) EntitiesWithComponentType(parentCtx context.Context, componen
 	return b.redisStore.Sort(ctx, b.keyEntitiesWithComponentType(component), componentTypes...)
 }
 
+// allComponentTypes returns an empty component of every type.
+func allComponentTypes() []proto.Message {
+	return []proto.Message{&Position{}, &Render{}, &Looker{}, &Named{}, &Speaker{}, &Moveable{}, &Readable{}, &Opaque{}, &Stealth{}, &Perception{}, &LightSource{}, &BlockList{}, &Role{}, &Authored{}, &Expires{}, &Character{}}
+}
+
 func (b *Registry) keyEntitiesWithComponentType(component proto.Message) string {
 	return fmt.Sprintf("by_component:%v", component.ProtoReflect().Descriptor().FullName().Name())
 }
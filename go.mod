@@ -11,6 +11,7 @@ require (
 	github.com/nats-io/jsm.go v0.0.22
 	github.com/nats-io/nats.go v1.10.1-0.20210228004050-ed743748acac
 	github.com/peterh/liner v1.2.1
+	github.com/prometheus/client_golang v1.9.0
 	github.com/stretchr/objx v0.3.0 // indirect
 	github.com/stretchr/testify v1.7.0
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.19.0
@@ -20,9 +21,10 @@ require (
 	go.opentelemetry.io/otel/trace v0.19.0
 	go.uber.org/multierr v1.6.0 // indirect
 	go.uber.org/zap v1.16.0
+	golang.org/x/crypto v0.0.0-20201016220609-9e8e0b390897
 	golang.org/x/image v0.0.0-20210220032944-ac19c3e999fb
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
 	google.golang.org/grpc v1.36.0
 	google.golang.org/protobuf v1.25.0
-	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776
 )
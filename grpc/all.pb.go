@@ -29,6 +29,8 @@ type VisibilityUpdate_Action int32
 const (
 	VisibilityUpdate_ADD    VisibilityUpdate_Action = 0
 	VisibilityUpdate_REMOVE VisibilityUpdate_Action = 1
+	// Only carries what changed since the last update of the same renderable, in `delta`.
+	VisibilityUpdate_UPDATE VisibilityUpdate_Action = 2
 )
 
 // Enum value maps for VisibilityUpdate_Action.
@@ -36,10 +38,12 @@ var (
 	VisibilityUpdate_Action_name = map[int32]string{
 		0: "ADD",
 		1: "REMOVE",
+		2: "UPDATE",
 	}
 	VisibilityUpdate_Action_value = map[string]int32{
 		"ADD":    0,
 		"REMOVE": 1,
+		"UPDATE": 2,
 	}
 )
 
@@ -70,6 +74,61 @@ func (VisibilityUpdate_Action) EnumDescriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type ChatMessage_Kind int32
+
+const (
+	ChatMessage_PLAYER  ChatMessage_Kind = 0
+	ChatMessage_SYSTEM  ChatMessage_Kind = 1
+	ChatMessage_EMOTE   ChatMessage_Kind = 2
+	ChatMessage_WHISPER ChatMessage_Kind = 3
+	ChatMessage_ERROR   ChatMessage_Kind = 4
+)
+
+// Enum value maps for ChatMessage_Kind.
+var (
+	ChatMessage_Kind_name = map[int32]string{
+		0: "PLAYER",
+		1: "SYSTEM",
+		2: "EMOTE",
+		3: "WHISPER",
+		4: "ERROR",
+	}
+	ChatMessage_Kind_value = map[string]int32{
+		"PLAYER":  0,
+		"SYSTEM":  1,
+		"EMOTE":   2,
+		"WHISPER": 3,
+		"ERROR":   4,
+	}
+)
+
+func (x ChatMessage_Kind) Enum() *ChatMessage_Kind {
+	p := new(ChatMessage_Kind)
+	*p = x
+	return p
+}
+
+func (x ChatMessage_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatMessage_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_all_proto_enumTypes[1].Descriptor()
+}
+
+func (ChatMessage_Kind) Type() protoreflect.EnumType {
+	return &file_all_proto_enumTypes[1]
+}
+
+func (x ChatMessage_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatMessage_Kind.Descriptor instead.
+func (ChatMessage_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{36, 0}
+}
+
 type TickUpdatesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -114,6 +173,10 @@ type TickUpdatesRes struct {
 	unknownFields protoimpl.UnknownFields
 
 	VisibilityUpdates []*VisibilityUpdate `protobuf:"bytes,1,rep,name=visibilityUpdates,proto3" json:"visibilityUpdates,omitempty"`
+	// Set when the updates contain everything the player sees. Anything else has to be forgotten.
+	Resync bool `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`
+	// Set when the tick duration changes, so clients can keep their own tick in sync.
+	TickMilliseconds int32 `protobuf:"varint,3,opt,name=tick_milliseconds,json=tickMilliseconds,proto3" json:"tick_milliseconds,omitempty"`
 }
 
 func (x *TickUpdatesRes) Reset() {
@@ -155,6 +218,20 @@ func (x *TickUpdatesRes) GetVisibilityUpdates() []*VisibilityUpdate {
 	return nil
 }
 
+func (x *TickUpdatesRes) GetResync() bool {
+	if x != nil {
+		return x.Resync
+	}
+	return false
+}
+
+func (x *TickUpdatesRes) GetTickMilliseconds() int32 {
+	if x != nil {
+		return x.TickMilliseconds
+	}
+	return 0
+}
+
 type VisibilityUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -163,6 +240,7 @@ type VisibilityUpdate struct {
 	Renderable *Renderable             `protobuf:"bytes,1,opt,name=renderable,proto3" json:"renderable,omitempty"`
 	Action     VisibilityUpdate_Action `protobuf:"varint,2,opt,name=action,proto3,enum=grpc.VisibilityUpdate_Action" json:"action,omitempty"`
 	Tick       int64                   `protobuf:"varint,3,opt,name=tick,proto3" json:"tick,omitempty"`
+	Delta      *RenderableDelta        `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
 }
 
 func (x *VisibilityUpdate) Reset() {
@@ -218,14 +296,32 @@ func (x *VisibilityUpdate) GetTick() int64 {
 	return 0
 }
 
-type ChatUpdatesReq struct {
+func (x *VisibilityUpdate) GetDelta() *RenderableDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+type SessionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Chosen by the client, and sent back in the ack of the command.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The tick the command is for, like the `tick` metadata of the other calls. Zero means the next one.
+	Tick int64 `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	// Types that are assignable to Command:
+	//	*SessionReq_Velocity
+	//	*SessionReq_Say
+	//	*SessionReq_Read
+	//	*SessionReq_Resync
+	Command isSessionReq_Command `protobuf_oneof:"command"`
 }
 
-func (x *ChatUpdatesReq) Reset() {
-	*x = ChatUpdatesReq{}
+func (x *SessionReq) Reset() {
+	*x = SessionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -233,13 +329,13 @@ func (x *ChatUpdatesReq) Reset() {
 	}
 }
 
-func (x *ChatUpdatesReq) String() string {
+func (x *SessionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatUpdatesReq) ProtoMessage() {}
+func (*SessionReq) ProtoMessage() {}
 
-func (x *ChatUpdatesReq) ProtoReflect() protoreflect.Message {
+func (x *SessionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,21 +347,97 @@ func (x *ChatUpdatesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatUpdatesReq.ProtoReflect.Descriptor instead.
-func (*ChatUpdatesReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionReq.ProtoReflect.Descriptor instead.
+func (*SessionReq) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{3}
 }
 
-type ChatUpdatesRes struct {
+func (x *SessionReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SessionReq) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (m *SessionReq) GetCommand() isSessionReq_Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (x *SessionReq) GetVelocity() *Velocity {
+	if x, ok := x.GetCommand().(*SessionReq_Velocity); ok {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *SessionReq) GetSay() *SayReq {
+	if x, ok := x.GetCommand().(*SessionReq_Say); ok {
+		return x.Say
+	}
+	return nil
+}
+
+func (x *SessionReq) GetRead() *ReadReq {
+	if x, ok := x.GetCommand().(*SessionReq_Read); ok {
+		return x.Read
+	}
+	return nil
+}
+
+func (x *SessionReq) GetResync() *ResyncReq {
+	if x, ok := x.GetCommand().(*SessionReq_Resync); ok {
+		return x.Resync
+	}
+	return nil
+}
+
+type isSessionReq_Command interface {
+	isSessionReq_Command()
+}
+
+type SessionReq_Velocity struct {
+	Velocity *Velocity `protobuf:"bytes,3,opt,name=velocity,proto3,oneof"`
+}
+
+type SessionReq_Say struct {
+	Say *SayReq `protobuf:"bytes,4,opt,name=say,proto3,oneof"`
+}
+
+type SessionReq_Read struct {
+	Read *ReadReq `protobuf:"bytes,5,opt,name=read,proto3,oneof"`
+}
+
+type SessionReq_Resync struct {
+	Resync *ResyncReq `protobuf:"bytes,6,opt,name=resync,proto3,oneof"`
+}
+
+func (*SessionReq_Velocity) isSessionReq_Command() {}
+
+func (*SessionReq_Say) isSessionReq_Command() {}
+
+func (*SessionReq_Read) isSessionReq_Command() {}
+
+func (*SessionReq_Resync) isSessionReq_Command() {}
+
+// Asks for the full state, for when the client can't apply an update.
+type ResyncReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Message *ChatMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ChatUpdatesRes) Reset() {
-	*x = ChatUpdatesRes{}
+func (x *ResyncReq) Reset() {
+	*x = ResyncReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -273,13 +445,13 @@ func (x *ChatUpdatesRes) Reset() {
 	}
 }
 
-func (x *ChatUpdatesRes) String() string {
+func (x *ResyncReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatUpdatesRes) ProtoMessage() {}
+func (*ResyncReq) ProtoMessage() {}
 
-func (x *ChatUpdatesRes) ProtoReflect() protoreflect.Message {
+func (x *ResyncReq) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,26 +463,26 @@ func (x *ChatUpdatesRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatUpdatesRes.ProtoReflect.Descriptor instead.
-func (*ChatUpdatesRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResyncReq.ProtoReflect.Descriptor instead.
+func (*ResyncReq) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ChatUpdatesRes) GetMessage() *ChatMessage {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-type MoveReq struct {
+type SessionRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*SessionRes_TickUpdates
+	//	*SessionRes_Chat
+	//	*SessionRes_Ack
+	//	*SessionRes_TickSync
+	Event isSessionRes_Event `protobuf_oneof:"event"`
 }
 
-func (x *MoveReq) Reset() {
-	*x = MoveReq{}
+func (x *SessionRes) Reset() {
+	*x = SessionRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -318,13 +490,13 @@ func (x *MoveReq) Reset() {
 	}
 }
 
-func (x *MoveReq) String() string {
+func (x *SessionRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveReq) ProtoMessage() {}
+func (*SessionRes) ProtoMessage() {}
 
-func (x *MoveReq) ProtoReflect() protoreflect.Message {
+func (x *SessionRes) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,19 +508,89 @@ func (x *MoveReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveReq.ProtoReflect.Descriptor instead.
-func (*MoveReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionRes.ProtoReflect.Descriptor instead.
+func (*SessionRes) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{5}
 }
 
-type MoveRes struct {
+func (m *SessionRes) GetEvent() isSessionRes_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *SessionRes) GetTickUpdates() *TickUpdatesRes {
+	if x, ok := x.GetEvent().(*SessionRes_TickUpdates); ok {
+		return x.TickUpdates
+	}
+	return nil
+}
+
+func (x *SessionRes) GetChat() *ChatMessage {
+	if x, ok := x.GetEvent().(*SessionRes_Chat); ok {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *SessionRes) GetAck() *SessionAck {
+	if x, ok := x.GetEvent().(*SessionRes_Ack); ok {
+		return x.Ack
+	}
+	return nil
+}
+
+func (x *SessionRes) GetTickSync() *TickSync {
+	if x, ok := x.GetEvent().(*SessionRes_TickSync); ok {
+		return x.TickSync
+	}
+	return nil
+}
+
+type isSessionRes_Event interface {
+	isSessionRes_Event()
+}
+
+type SessionRes_TickUpdates struct {
+	// The session starts with the full state, with `resync` set.
+	TickUpdates *TickUpdatesRes `protobuf:"bytes,1,opt,name=tick_updates,json=tickUpdates,proto3,oneof"`
+}
+
+type SessionRes_Chat struct {
+	Chat *ChatMessage `protobuf:"bytes,2,opt,name=chat,proto3,oneof"`
+}
+
+type SessionRes_Ack struct {
+	Ack *SessionAck `protobuf:"bytes,3,opt,name=ack,proto3,oneof"`
+}
+
+type SessionRes_TickSync struct {
+	TickSync *TickSync `protobuf:"bytes,4,opt,name=tick_sync,json=tickSync,proto3,oneof"`
+}
+
+func (*SessionRes_TickUpdates) isSessionRes_Event() {}
+
+func (*SessionRes_Chat) isSessionRes_Event() {}
+
+func (*SessionRes_Ack) isSessionRes_Event() {}
+
+func (*SessionRes_TickSync) isSessionRes_Event() {}
+
+type SessionAck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The tick of the server when it got the command.
+	Tick int64 `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	// Why the command was rejected. Empty if it wasn't.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *MoveRes) Reset() {
-	*x = MoveRes{}
+func (x *SessionAck) Reset() {
+	*x = SessionAck{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -356,13 +598,13 @@ func (x *MoveRes) Reset() {
 	}
 }
 
-func (x *MoveRes) String() string {
+func (x *SessionAck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveRes) ProtoMessage() {}
+func (*SessionAck) ProtoMessage() {}
 
-func (x *MoveRes) ProtoReflect() protoreflect.Message {
+func (x *SessionAck) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,21 +616,44 @@ func (x *MoveRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveRes.ProtoReflect.Descriptor instead.
-func (*MoveRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionAck.ProtoReflect.Descriptor instead.
+func (*SessionAck) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{6}
 }
 
-type ReadReq struct {
+func (x *SessionAck) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SessionAck) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *SessionAck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Sent when the session starts and when the tick duration changes.
+type TickSync struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Tick             int64 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	TickMilliseconds int32 `protobuf:"varint,2,opt,name=tick_milliseconds,json=tickMilliseconds,proto3" json:"tick_milliseconds,omitempty"`
 }
 
-func (x *ReadReq) Reset() {
-	*x = ReadReq{}
+func (x *TickSync) Reset() {
+	*x = TickSync{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -396,13 +661,13 @@ func (x *ReadReq) Reset() {
 	}
 }
 
-func (x *ReadReq) String() string {
+func (x *TickSync) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadReq) ProtoMessage() {}
+func (*TickSync) ProtoMessage() {}
 
-func (x *ReadReq) ProtoReflect() protoreflect.Message {
+func (x *TickSync) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,26 +679,33 @@ func (x *ReadReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadReq.ProtoReflect.Descriptor instead.
-func (*ReadReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use TickSync.ProtoReflect.Descriptor instead.
+func (*TickSync) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ReadReq) GetPosition() *Position {
+func (x *TickSync) GetTick() int64 {
 	if x != nil {
-		return x.Position
+		return x.Tick
 	}
-	return nil
+	return 0
 }
 
-type ReadRes struct {
+func (x *TickSync) GetTickMilliseconds() int32 {
+	if x != nil {
+		return x.TickMilliseconds
+	}
+	return 0
+}
+
+type ChatUpdatesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ReadRes) Reset() {
-	*x = ReadRes{}
+func (x *ChatUpdatesReq) Reset() {
+	*x = ChatUpdatesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -441,13 +713,13 @@ func (x *ReadRes) Reset() {
 	}
 }
 
-func (x *ReadRes) String() string {
+func (x *ChatUpdatesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadRes) ProtoMessage() {}
+func (*ChatUpdatesReq) ProtoMessage() {}
 
-func (x *ReadRes) ProtoReflect() protoreflect.Message {
+func (x *ChatUpdatesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -459,21 +731,21 @@ func (x *ReadRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadRes.ProtoReflect.Descriptor instead.
-func (*ReadRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatUpdatesReq.ProtoReflect.Descriptor instead.
+func (*ChatUpdatesReq) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{8}
 }
 
-type JoinReq struct {
+type ChatUpdatesRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *JoinReq) Reset() {
-	*x = JoinReq{}
+func (x *ChatUpdatesRes) Reset() {
+	*x = ChatUpdatesRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -481,13 +753,13 @@ func (x *JoinReq) Reset() {
 	}
 }
 
-func (x *JoinReq) String() string {
+func (x *ChatUpdatesRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinReq) ProtoMessage() {}
+func (*ChatUpdatesRes) ProtoMessage() {}
 
-func (x *JoinReq) ProtoReflect() protoreflect.Message {
+func (x *ChatUpdatesRes) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,29 +771,32 @@ func (x *JoinReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinReq.ProtoReflect.Descriptor instead.
-func (*JoinReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatUpdatesRes.ProtoReflect.Descriptor instead.
+func (*ChatUpdatesRes) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *JoinReq) GetName() string {
+func (x *ChatUpdatesRes) GetMessage() *ChatMessage {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-type JoinRes struct {
+type ChatHistoryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerId         int64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	TickMilliseconds int32 `protobuf:"varint,2,opt,name=tickMilliseconds,proto3" json:"tickMilliseconds,omitempty"`
+	// local, global, whisper or the name of a channel the player joined. Whispers include the system messages.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Only messages with an id lower than this are returned. Zero means now.
+	Before int64 `protobuf:"varint,2,opt,name=before,proto3" json:"before,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *JoinRes) Reset() {
-	*x = JoinRes{}
+func (x *ChatHistoryReq) Reset() {
+	*x = ChatHistoryReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -529,13 +804,13 @@ func (x *JoinRes) Reset() {
 	}
 }
 
-func (x *JoinRes) String() string {
+func (x *ChatHistoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRes) ProtoMessage() {}
+func (*ChatHistoryReq) ProtoMessage() {}
 
-func (x *JoinRes) ProtoReflect() protoreflect.Message {
+func (x *ChatHistoryReq) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -547,35 +822,43 @@ func (x *JoinRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRes.ProtoReflect.Descriptor instead.
-func (*JoinRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatHistoryReq.ProtoReflect.Descriptor instead.
+func (*ChatHistoryReq) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *JoinRes) GetPlayerId() int64 {
+func (x *ChatHistoryReq) GetChannel() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Channel
 	}
-	return 0
+	return ""
 }
 
-func (x *JoinRes) GetTickMilliseconds() int32 {
+func (x *ChatHistoryReq) GetBefore() int64 {
 	if x != nil {
-		return x.TickMilliseconds
+		return x.Before
 	}
 	return 0
 }
 
-type SayReq struct {
+func (x *ChatHistoryReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ChatHistoryRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// From the oldest to the newest. To get the previous page, use the id of the first one as `before`.
+	Messages []*ChatMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *SayReq) Reset() {
-	*x = SayReq{}
+func (x *ChatHistoryRes) Reset() {
+	*x = ChatHistoryRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -583,13 +866,13 @@ func (x *SayReq) Reset() {
 	}
 }
 
-func (x *SayReq) String() string {
+func (x *ChatHistoryRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SayReq) ProtoMessage() {}
+func (*ChatHistoryRes) ProtoMessage() {}
 
-func (x *SayReq) ProtoReflect() protoreflect.Message {
+func (x *ChatHistoryRes) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -601,26 +884,26 @@ func (x *SayReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SayReq.ProtoReflect.Descriptor instead.
-func (*SayReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatHistoryRes.ProtoReflect.Descriptor instead.
+func (*ChatHistoryRes) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SayReq) GetText() string {
+func (x *ChatHistoryRes) GetMessages() []*ChatMessage {
 	if x != nil {
-		return x.Text
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-type SayRes struct {
+type MoveReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SayRes) Reset() {
-	*x = SayRes{}
+func (x *MoveReq) Reset() {
+	*x = MoveReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -628,13 +911,13 @@ func (x *SayRes) Reset() {
 	}
 }
 
-func (x *SayRes) String() string {
+func (x *MoveReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SayRes) ProtoMessage() {}
+func (*MoveReq) ProtoMessage() {}
 
-func (x *SayRes) ProtoReflect() protoreflect.Message {
+func (x *MoveReq) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -646,25 +929,19 @@ func (x *SayRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SayRes.ProtoReflect.Descriptor instead.
-func (*SayRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveReq.ProtoReflect.Descriptor instead.
+func (*MoveReq) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{12}
 }
 
-type Renderable struct {
+type MoveRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id       int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	Velocity *Velocity `protobuf:"bytes,3,opt,name=velocity,proto3" json:"velocity,omitempty"`
-	Char     string    `protobuf:"bytes,4,opt,name=char,proto3" json:"char,omitempty"`
-	Color    uint32    `protobuf:"varint,5,opt,name=color,proto3" json:"color,omitempty"`
 }
 
-func (x *Renderable) Reset() {
-	*x = Renderable{}
+func (x *MoveRes) Reset() {
+	*x = MoveRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_all_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,13 +949,13 @@ func (x *Renderable) Reset() {
 	}
 }
 
-func (x *Renderable) String() string {
+func (x *MoveRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Renderable) ProtoMessage() {}
+func (*MoveRes) ProtoMessage() {}
 
-func (x *Renderable) ProtoReflect() protoreflect.Message {
+func (x *MoveRes) ProtoReflect() protoreflect.Message {
 	mi := &file_all_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,72 +967,123 @@ func (x *Renderable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Renderable.ProtoReflect.Descriptor instead.
-func (*Renderable) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveRes.ProtoReflect.Descriptor instead.
+func (*MoveRes) Descriptor() ([]byte, []int) {
 	return file_all_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Renderable) GetId() int64 {
-	if x != nil {
-		return x.Id
+type ReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *ReadReq) Reset() {
+	*x = ReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Renderable) GetPosition() *Position {
-	if x != nil {
-		return x.Position
+func (x *ReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReq) ProtoMessage() {}
+
+func (x *ReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Renderable) GetVelocity() *Velocity {
+// Deprecated: Use ReadReq.ProtoReflect.Descriptor instead.
+func (*ReadReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReadReq) GetPosition() *Position {
 	if x != nil {
-		return x.Velocity
+		return x.Position
 	}
 	return nil
 }
 
-func (x *Renderable) GetChar() string {
-	if x != nil {
-		return x.Char
+type ReadRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReadRes) Reset() {
+	*x = ReadRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Renderable) GetColor() uint32 {
-	if x != nil {
-		return x.Color
+func (x *ReadRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRes) ProtoMessage() {}
+
+func (x *ReadRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type ChatMessage struct {
+// Deprecated: Use ReadRes.ProtoReflect.Descriptor instead.
+func (*ReadRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{15}
+}
+
+// Accounts are created the first time someone logs in with their name.
+type LoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *ChatMessage) Reset() {
-	*x = ChatMessage{}
+func (x *LoginReq) Reset() {
+	*x = LoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_all_proto_msgTypes[14]
+		mi := &file_all_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatMessage) String() string {
+func (x *LoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessage) ProtoMessage() {}
+func (*LoginReq) ProtoMessage() {}
 
-func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_all_proto_msgTypes[14]
+func (x *LoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,51 +1094,51 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
-func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_all_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
+func (*LoginReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ChatMessage) GetFrom() string {
+func (x *LoginReq) GetName() string {
 	if x != nil {
-		return x.From
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetText() string {
+func (x *LoginReq) GetPassword() string {
 	if x != nil {
-		return x.Text
+		return x.Password
 	}
 	return ""
 }
 
-type Position struct {
+type LoginRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y int64 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// Has to be sent in the `session` metadata of every following request.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *Position) Reset() {
-	*x = Position{}
+func (x *LoginRes) Reset() {
+	*x = LoginRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_all_proto_msgTypes[15]
+		mi := &file_all_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Position) String() string {
+func (x *LoginRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Position) ProtoMessage() {}
+func (*LoginRes) ProtoMessage() {}
 
-func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_all_proto_msgTypes[15]
+func (x *LoginRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,51 +1149,85 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Position.ProtoReflect.Descriptor instead.
-func (*Position) Descriptor() ([]byte, []int) {
-	return file_all_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use LoginRes.ProtoReflect.Descriptor instead.
+func (*LoginRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Position) GetX() int64 {
+func (x *LoginRes) GetToken() string {
 	if x != nil {
-		return x.X
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *Position) GetY() int64 {
-	if x != nil {
-		return x.Y
+// Joins with the character of the account of the session.
+type JoinReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *JoinReq) Reset() {
+	*x = JoinReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type Velocity struct {
+func (x *JoinReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinReq) ProtoMessage() {}
+
+func (x *JoinReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinReq.ProtoReflect.Descriptor instead.
+func (*JoinReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{18}
+}
+
+type JoinRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y int64 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	PlayerId         int64 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TickMilliseconds int32 `protobuf:"varint,2,opt,name=tickMilliseconds,proto3" json:"tickMilliseconds,omitempty"`
+	// How many ticks a day lasts. Zero when there are no nights.
+	DayLength int64 `protobuf:"varint,3,opt,name=dayLength,proto3" json:"dayLength,omitempty"`
 }
 
-func (x *Velocity) Reset() {
-	*x = Velocity{}
+func (x *JoinRes) Reset() {
+	*x = JoinRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_all_proto_msgTypes[16]
+		mi := &file_all_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Velocity) String() string {
+func (x *JoinRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Velocity) ProtoMessage() {}
+func (*JoinRes) ProtoMessage() {}
 
-func (x *Velocity) ProtoReflect() protoreflect.Message {
-	mi := &file_all_proto_msgTypes[16]
+func (x *JoinRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,713 +1238,4121 @@ func (x *Velocity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Velocity.ProtoReflect.Descriptor instead.
-func (*Velocity) Descriptor() ([]byte, []int) {
-	return file_all_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use JoinRes.ProtoReflect.Descriptor instead.
+func (*JoinRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Velocity) GetX() int64 {
+func (x *JoinRes) GetPlayerId() int64 {
 	if x != nil {
-		return x.X
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *Velocity) GetY() int64 {
+func (x *JoinRes) GetTickMilliseconds() int32 {
 	if x != nil {
-		return x.Y
+		return x.TickMilliseconds
 	}
 	return 0
 }
 
-var File_all_proto protoreflect.FileDescriptor
+func (x *JoinRes) GetDayLength() int64 {
+	if x != nil {
+		return x.DayLength
+	}
+	return 0
+}
 
-var file_all_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x72, 0x70,
-	0x63, 0x22, 0x10, 0x0a, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x22, 0x56, 0x0a, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x11, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x10,
-	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x30, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x22, 0x1d, 0x0a,
-	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x22, 0x10, 0x0a, 0x0e,
-	0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x3d,
-	0x0a, 0x0e, 0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x09, 0x0a,
-	0x07, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x22, 0x09, 0x0a, 0x07, 0x4d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x2a,
-	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x09, 0x0a, 0x07, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x07, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x07, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10,
-	0x74, 0x69, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x69, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x6c,
-	0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x1c, 0x0a, 0x06, 0x53, 0x61, 0x79, 0x52,
-	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x08, 0x0a, 0x06, 0x53, 0x61, 0x79, 0x52, 0x65, 0x73,
-	0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x76,
-	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x76,
-	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x22, 0x35, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x26, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x79,
-	0x22, 0x26, 0x0a, 0x08, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x0c, 0x0a, 0x01,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x79, 0x32, 0xaa, 0x02, 0x0a, 0x05, 0x45, 0x73, 0x69,
-	0x76, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54,
-	0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
-	0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x2e, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12,
-	0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x1a,
-	0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00,
-	0x12, 0x26, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x23, 0x0a, 0x03, 0x53, 0x61, 0x79, 0x12,
-	0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x61, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x61, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x26, 0x0a,
-	0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x22, 0x00, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x2d, 0x63, 0x65, 0x6c, 0x6c, 0x2f, 0x65, 0x73,
-	0x69, 0x76, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type ResumeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The session token of the player.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-var (
-	file_all_proto_rawDescOnce sync.Once
-	file_all_proto_rawDescData = file_all_proto_rawDesc
-)
+func (x *ResumeReq) Reset() {
+	*x = ResumeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_all_proto_rawDescGZIP() []byte {
-	file_all_proto_rawDescOnce.Do(func() {
-		file_all_proto_rawDescData = protoimpl.X.CompressGZIP(file_all_proto_rawDescData)
-	})
-	return file_all_proto_rawDescData
+func (x *ResumeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_all_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_all_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_all_proto_goTypes = []interface{}{
-	(VisibilityUpdate_Action)(0), // 0: grpc.VisibilityUpdate.Action
-	(*TickUpdatesReq)(nil),       // 1: grpc.TickUpdatesReq
-	(*TickUpdatesRes)(nil),       // 2: grpc.TickUpdatesRes
-	(*VisibilityUpdate)(nil),     // 3: grpc.VisibilityUpdate
-	(*ChatUpdatesReq)(nil),       // 4: grpc.ChatUpdatesReq
-	(*ChatUpdatesRes)(nil),       // 5: grpc.ChatUpdatesRes
-	(*MoveReq)(nil),              // 6: grpc.MoveReq
-	(*MoveRes)(nil),              // 7: grpc.MoveRes
-	(*ReadReq)(nil),              // 8: grpc.ReadReq
-	(*ReadRes)(nil),              // 9: grpc.ReadRes
-	(*JoinReq)(nil),              // 10: grpc.JoinReq
-	(*JoinRes)(nil),              // 11: grpc.JoinRes
-	(*SayReq)(nil),               // 12: grpc.SayReq
-	(*SayRes)(nil),               // 13: grpc.SayRes
-	(*Renderable)(nil),           // 14: grpc.Renderable
-	(*ChatMessage)(nil),          // 15: grpc.ChatMessage
-	(*Position)(nil),             // 16: grpc.Position
-	(*Velocity)(nil),             // 17: grpc.Velocity
+func (*ResumeReq) ProtoMessage() {}
+
+func (x *ResumeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_all_proto_depIdxs = []int32{
-	3,  // 0: grpc.TickUpdatesRes.visibilityUpdates:type_name -> grpc.VisibilityUpdate
-	14, // 1: grpc.VisibilityUpdate.renderable:type_name -> grpc.Renderable
-	0,  // 2: grpc.VisibilityUpdate.action:type_name -> grpc.VisibilityUpdate.Action
-	15, // 3: grpc.ChatUpdatesRes.message:type_name -> grpc.ChatMessage
-	16, // 4: grpc.ReadReq.position:type_name -> grpc.Position
-	16, // 5: grpc.Renderable.position:type_name -> grpc.Position
-	17, // 6: grpc.Renderable.velocity:type_name -> grpc.Velocity
-	1,  // 7: grpc.Esive.TickUpdates:input_type -> grpc.TickUpdatesReq
-	4,  // 8: grpc.Esive.ChatUpdates:input_type -> grpc.ChatUpdatesReq
-	17, // 9: grpc.Esive.SetVelocity:input_type -> grpc.Velocity
-	8,  // 10: grpc.Esive.Read:input_type -> grpc.ReadReq
-	12, // 11: grpc.Esive.Say:input_type -> grpc.SayReq
-	10, // 12: grpc.Esive.Join:input_type -> grpc.JoinReq
-	2,  // 13: grpc.Esive.TickUpdates:output_type -> grpc.TickUpdatesRes
-	5,  // 14: grpc.Esive.ChatUpdates:output_type -> grpc.ChatUpdatesRes
-	7,  // 15: grpc.Esive.SetVelocity:output_type -> grpc.MoveRes
-	9,  // 16: grpc.Esive.Read:output_type -> grpc.ReadRes
-	13, // 17: grpc.Esive.Say:output_type -> grpc.SayRes
-	11, // 18: grpc.Esive.Join:output_type -> grpc.JoinRes
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+
+// Deprecated: Use ResumeReq.ProtoReflect.Descriptor instead.
+func (*ResumeReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{20}
 }
 
-func init() { file_all_proto_init() }
-func file_all_proto_init() {
-	if File_all_proto != nil {
-		return
+func (x *ResumeReq) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_all_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TickUpdatesReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_all_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TickUpdatesRes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+type SayReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SayReq) Reset() {
+	*x = SayReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SayReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayReq) ProtoMessage() {}
+
+func (x *SayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VisibilityUpdate); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayReq.ProtoReflect.Descriptor instead.
+func (*SayReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SayReq) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SayRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SayRes) Reset() {
+	*x = SayRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SayRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SayRes) ProtoMessage() {}
+
+func (x *SayRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatUpdatesReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SayRes.ProtoReflect.Descriptor instead.
+func (*SayRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{22}
+}
+
+type CompleteCommandReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The partial command line, including the leading `/`.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *CompleteCommandReq) Reset() {
+	*x = CompleteCommandReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteCommandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCommandReq) ProtoMessage() {}
+
+func (x *CompleteCommandReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatUpdatesRes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCommandReq.ProtoReflect.Descriptor instead.
+func (*CompleteCommandReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CompleteCommandReq) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CompleteCommandRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Full command lines, sorted.
+	Completions []string `protobuf:"bytes,1,rep,name=completions,proto3" json:"completions,omitempty"`
+}
+
+func (x *CompleteCommandRes) Reset() {
+	*x = CompleteCommandRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteCommandRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCommandRes) ProtoMessage() {}
+
+func (x *CompleteCommandRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_all_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveRes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_all_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCommandRes.ProtoReflect.Descriptor instead.
+func (*CompleteCommandRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CompleteCommandRes) GetCompletions() []string {
+	if x != nil {
+		return x.Completions
+	}
+	return nil
+}
+
+type Mail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Unix time in milliseconds.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Read      bool  `protobuf:"varint,4,opt,name=read,proto3" json:"read,omitempty"`
+	// Only set when the mail is read.
+	Text string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Mail) Reset() {
+	*x = Mail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail) ProtoMessage() {}
+
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadRes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Mail) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Mail) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Mail) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Mail) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Mail) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ListMailReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMailReq) Reset() {
+	*x = ListMailReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMailReq) ProtoMessage() {}
+
+func (x *ListMailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMailReq.ProtoReflect.Descriptor instead.
+func (*ListMailReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{26}
+}
+
+type ListMailRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// From the oldest to the newest.
+	Mails []*Mail `protobuf:"bytes,1,rep,name=mails,proto3" json:"mails,omitempty"`
+}
+
+func (x *ListMailRes) Reset() {
+	*x = ListMailRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMailRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMailRes) ProtoMessage() {}
+
+func (x *ListMailRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMailRes.ProtoReflect.Descriptor instead.
+func (*ListMailRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMailRes) GetMails() []*Mail {
+	if x != nil {
+		return x.Mails
+	}
+	return nil
+}
+
+type ReadMailReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReadMailReq) Reset() {
+	*x = ReadMailReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadMailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMailReq) ProtoMessage() {}
+
+func (x *ReadMailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SayReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMailReq.ProtoReflect.Descriptor instead.
+func (*ReadMailReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadMailReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReadMailRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mail *Mail `protobuf:"bytes,1,opt,name=mail,proto3" json:"mail,omitempty"`
+}
+
+func (x *ReadMailRes) Reset() {
+	*x = ReadMailRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadMailRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMailRes) ProtoMessage() {}
+
+func (x *ReadMailRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SayRes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_all_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Renderable); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMailRes.ProtoReflect.Descriptor instead.
+func (*ReadMailRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReadMailRes) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+type DeleteMailReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteMailReq) Reset() {
+	*x = DeleteMailReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMailReq) ProtoMessage() {}
+
+func (x *DeleteMailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatMessage); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMailReq.ProtoReflect.Descriptor instead.
+func (*DeleteMailReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteMailReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteMailRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteMailRes) Reset() {
+	*x = DeleteMailRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMailRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMailRes) ProtoMessage() {}
+
+func (x *DeleteMailRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Position); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMailRes.ProtoReflect.Descriptor instead.
+func (*DeleteMailRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{31}
+}
+
+type Renderable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Velocity *Velocity `protobuf:"bytes,3,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Char     string    `protobuf:"bytes,4,opt,name=char,proto3" json:"char,omitempty"`
+	Color    uint32    `protobuf:"varint,5,opt,name=color,proto3" json:"color,omitempty"`
+	// Only set for light sources.
+	Light *Light `protobuf:"bytes,6,opt,name=light,proto3" json:"light,omitempty"`
+}
+
+func (x *Renderable) Reset() {
+	*x = Renderable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Renderable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Renderable) ProtoMessage() {}
+
+func (x *Renderable) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_all_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Velocity); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Renderable.ProtoReflect.Descriptor instead.
+func (*Renderable) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Renderable) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Renderable) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Renderable) GetVelocity() *Velocity {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *Renderable) GetChar() string {
+	if x != nil {
+		return x.Char
+	}
+	return ""
+}
+
+func (x *Renderable) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *Renderable) GetLight() *Light {
+	if x != nil {
+		return x.Light
+	}
+	return nil
+}
+
+// Fields are only set when they changed.
+type RenderableDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position   *Position   `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Velocity   *Velocity   `protobuf:"bytes,3,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Appearance *Appearance `protobuf:"bytes,4,opt,name=appearance,proto3" json:"appearance,omitempty"`
+}
+
+func (x *RenderableDelta) Reset() {
+	*x = RenderableDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderableDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderableDelta) ProtoMessage() {}
+
+func (x *RenderableDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_all_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   17,
-			NumExtensions: 0,
-			NumServices:   1,
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderableDelta.ProtoReflect.Descriptor instead.
+func (*RenderableDelta) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RenderableDelta) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenderableDelta) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *RenderableDelta) GetVelocity() *Velocity {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *RenderableDelta) GetAppearance() *Appearance {
+	if x != nil {
+		return x.Appearance
+	}
+	return nil
+}
+
+type Appearance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Char  string `protobuf:"bytes,1,opt,name=char,proto3" json:"char,omitempty"`
+	Color uint32 `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"`
+	Light *Light `protobuf:"bytes,3,opt,name=light,proto3" json:"light,omitempty"`
+}
+
+func (x *Appearance) Reset() {
+	*x = Appearance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Appearance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appearance) ProtoMessage() {}
+
+func (x *Appearance) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appearance.ProtoReflect.Descriptor instead.
+func (*Appearance) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Appearance) GetChar() string {
+	if x != nil {
+		return x.Char
+	}
+	return ""
+}
+
+func (x *Appearance) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *Appearance) GetLight() *Light {
+	if x != nil {
+		return x.Light
+	}
+	return nil
+}
+
+type Light struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Radius float32 `protobuf:"fixed32,1,opt,name=radius,proto3" json:"radius,omitempty"`
+	Color  uint32  `protobuf:"varint,2,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *Light) Reset() {
+	*x = Light{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Light) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Light) ProtoMessage() {}
+
+func (x *Light) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Light.ProtoReflect.Descriptor instead.
+func (*Light) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Light) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *Light) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// local, global, whisper or the name of a channel the player joined. Empty for system messages.
+	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Only set for whispers.
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Unix time in milliseconds.
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Entity of the sender. 0 for system messages.
+	FromId int64 `protobuf:"varint,6,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	// Server tick when it was sent.
+	Tick int64            `protobuf:"varint,7,opt,name=tick,proto3" json:"tick,omitempty"`
+	Kind ChatMessage_Kind `protobuf:"varint,8,opt,name=kind,proto3,enum=grpc.ChatMessage_Kind" json:"kind,omitempty"`
+	// Increases with every message logged, so it orders them even when they share a timestamp. 0 if it wasn't logged.
+	Id int64 `protobuf:"varint,9,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChatMessage) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetFromId() int64 {
+	if x != nil {
+		return x.FromId
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetKind() ChatMessage_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return ChatMessage_PLAYER
+}
+
+func (x *ChatMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int64 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Position) GetX() int64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Position) GetY() int64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type Velocity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int64 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Velocity) Reset() {
+	*x = Velocity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Velocity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Velocity) ProtoMessage() {}
+
+func (x *Velocity) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Velocity.ProtoReflect.Descriptor instead.
+func (*Velocity) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Velocity) GetX() int64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Velocity) GetY() int64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity   int64     `protobuf:"varint,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Name     string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role     string    `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Position *Position `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	// False while the player is disconnected, waiting for them to resume their session.
+	Connected bool `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Player) GetEntity() int64 {
+	if x != nil {
+		return x.Entity
+	}
+	return 0
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Player) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Player) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+type ListPlayersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPlayersReq) Reset() {
+	*x = ListPlayersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersReq) ProtoMessage() {}
+
+func (x *ListPlayersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersReq.ProtoReflect.Descriptor instead.
+func (*ListPlayersReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{40}
+}
+
+type ListPlayersRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *ListPlayersRes) Reset() {
+	*x = ListPlayersRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersRes) ProtoMessage() {}
+
+func (x *ListPlayersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersRes.ProtoReflect.Descriptor instead.
+func (*ListPlayersRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListPlayersRes) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type InspectEntityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity int64 `protobuf:"varint,1,opt,name=entity,proto3" json:"entity,omitempty"`
+}
+
+func (x *InspectEntityReq) Reset() {
+	*x = InspectEntityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectEntityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectEntityReq) ProtoMessage() {}
+
+func (x *InspectEntityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectEntityReq.ProtoReflect.Descriptor instead.
+func (*InspectEntityReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *InspectEntityReq) GetEntity() int64 {
+	if x != nil {
+		return x.Entity
+	}
+	return 0
+}
+
+type InspectEntityRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Every component of the entity as JSON, by component type.
+	Components map[string]string `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InspectEntityRes) Reset() {
+	*x = InspectEntityRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectEntityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectEntityRes) ProtoMessage() {}
+
+func (x *InspectEntityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectEntityRes.ProtoReflect.Descriptor instead.
+func (*InspectEntityRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InspectEntityRes) GetComponents() map[string]string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type TeleportReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity   int64     `protobuf:"varint,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *TeleportReq) Reset() {
+	*x = TeleportReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeleportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeleportReq) ProtoMessage() {}
+
+func (x *TeleportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeleportReq.ProtoReflect.Descriptor instead.
+func (*TeleportReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TeleportReq) GetEntity() int64 {
+	if x != nil {
+		return x.Entity
+	}
+	return 0
+}
+
+func (x *TeleportReq) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type TeleportRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TeleportRes) Reset() {
+	*x = TeleportRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeleportRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeleportRes) ProtoMessage() {}
+
+func (x *TeleportRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeleportRes.ProtoReflect.Descriptor instead.
+func (*TeleportRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{45}
+}
+
+type KickReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *KickReq) Reset() {
+	*x = KickReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickReq) ProtoMessage() {}
+
+func (x *KickReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickReq.ProtoReflect.Descriptor instead.
+func (*KickReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *KickReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KickReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type KickRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *KickRes) Reset() {
+	*x = KickRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KickRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickRes) ProtoMessage() {}
+
+func (x *KickRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickRes.ProtoReflect.Descriptor instead.
+func (*KickRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{47}
+}
+
+type BroadcastReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *BroadcastReq) Reset() {
+	*x = BroadcastReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastReq) ProtoMessage() {}
+
+func (x *BroadcastReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastReq.ProtoReflect.Descriptor instead.
+func (*BroadcastReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BroadcastReq) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type BroadcastRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BroadcastRes) Reset() {
+	*x = BroadcastRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastRes) ProtoMessage() {}
+
+func (x *BroadcastRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastRes.ProtoReflect.Descriptor instead.
+func (*BroadcastRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{49}
+}
+
+type SpawnReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// wall or lamp.
+	Prefab   string    `protobuf:"bytes,1,opt,name=prefab,proto3" json:"prefab,omitempty"`
+	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *SpawnReq) Reset() {
+	*x = SpawnReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpawnReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnReq) ProtoMessage() {}
+
+func (x *SpawnReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnReq.ProtoReflect.Descriptor instead.
+func (*SpawnReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SpawnReq) GetPrefab() string {
+	if x != nil {
+		return x.Prefab
+	}
+	return ""
+}
+
+func (x *SpawnReq) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type SpawnRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity int64 `protobuf:"varint,1,opt,name=entity,proto3" json:"entity,omitempty"`
+}
+
+func (x *SpawnRes) Reset() {
+	*x = SpawnRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpawnRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnRes) ProtoMessage() {}
+
+func (x *SpawnRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnRes.ProtoReflect.Descriptor instead.
+func (*SpawnRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SpawnRes) GetEntity() int64 {
+	if x != nil {
+		return x.Entity
+	}
+	return 0
+}
+
+type DeleteEntityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity int64 `protobuf:"varint,1,opt,name=entity,proto3" json:"entity,omitempty"`
+}
+
+func (x *DeleteEntityReq) Reset() {
+	*x = DeleteEntityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntityReq) ProtoMessage() {}
+
+func (x *DeleteEntityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntityReq.ProtoReflect.Descriptor instead.
+func (*DeleteEntityReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteEntityReq) GetEntity() int64 {
+	if x != nil {
+		return x.Entity
+	}
+	return 0
+}
+
+type DeleteEntityRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteEntityRes) Reset() {
+	*x = DeleteEntityRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntityRes) ProtoMessage() {}
+
+func (x *DeleteEntityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntityRes.ProtoReflect.Descriptor instead.
+func (*DeleteEntityRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{53}
+}
+
+type SetTickReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Zero keeps the current duration.
+	TickMilliseconds int32 `protobuf:"varint,2,opt,name=tick_milliseconds,json=tickMilliseconds,proto3" json:"tick_milliseconds,omitempty"`
+	// Runs this many ticks and pauses again. It takes precedence over `paused`.
+	Step int32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+}
+
+func (x *SetTickReq) Reset() {
+	*x = SetTickReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTickReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTickReq) ProtoMessage() {}
+
+func (x *SetTickReq) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTickReq.ProtoReflect.Descriptor instead.
+func (*SetTickReq) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetTickReq) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *SetTickReq) GetTickMilliseconds() int32 {
+	if x != nil {
+		return x.TickMilliseconds
+	}
+	return 0
+}
+
+func (x *SetTickReq) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+type SetTickRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tick             int64 `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Paused           bool  `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	TickMilliseconds int32 `protobuf:"varint,3,opt,name=tick_milliseconds,json=tickMilliseconds,proto3" json:"tick_milliseconds,omitempty"`
+}
+
+func (x *SetTickRes) Reset() {
+	*x = SetTickRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_all_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTickRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTickRes) ProtoMessage() {}
+
+func (x *SetTickRes) ProtoReflect() protoreflect.Message {
+	mi := &file_all_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTickRes.ProtoReflect.Descriptor instead.
+func (*SetTickRes) Descriptor() ([]byte, []int) {
+	return file_all_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetTickRes) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *SetTickRes) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *SetTickRes) GetTickMilliseconds() int32 {
+	if x != nil {
+		return x.TickMilliseconds
+	}
+	return 0
+}
+
+var File_all_proto protoreflect.FileDescriptor
+
+var file_all_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x72, 0x70,
+	0x63, 0x22, 0x10, 0x0a, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x22, 0x9b, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x11, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x74, 0x69, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0xe7, 0x01, 0x0a, 0x10, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x72, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
+	0x69, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x22, 0x29, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44,
+	0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22, 0xdb, 0x01, 0x0a, 0x0a,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x2c,
+	0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x48, 0x00, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x03,
+	0x73, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x61, 0x79, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x03, 0x73, 0x61, 0x79, 0x12, 0x23,
+	0x0a, 0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x04, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x09,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x0b, 0x0a, 0x09, 0x52, 0x65, 0x73,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x27, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x03, 0x61, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x12,
+	0x2d, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x79,
+	0x6e, 0x63, 0x48, 0x00, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x42, 0x07,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x4b, 0x0a, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12,
+	0x2b, 0x0a, 0x11, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x69, 0x63, 0x6b,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x10, 0x0a, 0x0e,
+	0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x3d,
+	0x0a, 0x0e, 0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a,
+	0x0e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3f, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x09, 0x0a, 0x07, 0x4d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x22, 0x09, 0x0a, 0x07, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x22, 0x35,
+	0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x22, 0x3a, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x20, 0x0a, 0x08,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0f,
+	0x0a, 0x07, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22,
+	0x70, 0x0a, 0x07, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x69, 0x63, 0x6b, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x74, 0x69, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x61, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x22, 0x21, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1c, 0x0a, 0x06, 0x53, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x08, 0x0a, 0x06, 0x53, 0x61, 0x79, 0x52, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x12,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x36, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70,
+	0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x22,
+	0x2f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x12, 0x20,
+	0x0a, 0x05, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x6d, 0x61, 0x69, 0x6c, 0x73,
+	0x22, 0x1d, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x2d, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x1f,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x76,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x76,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x68, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x12, 0x21, 0x0a, 0x05, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x05, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65,
+	0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x30, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x70, 0x70, 0x65,
+	0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0x59, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x68, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x05, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x35, 0x0a,
+	0x05, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x22, 0xa9, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63,
+	0x6b, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a,
+	0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x48, 0x49, 0x53,
+	0x50, 0x45, 0x52, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04,
+	0x22, 0x26, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x79, 0x22, 0x26, 0x0a, 0x08, 0x56, 0x65, 0x6c, 0x6f,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x79,
+	0x22, 0x92, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x22, 0x38, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x22, 0x2a, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x99, 0x01,
+	0x0a, 0x10, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x51, 0x0a, 0x0b, 0x54, 0x65, 0x6c,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0d, 0x0a, 0x0b,
+	0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x07, 0x4b,
+	0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x09, 0x0a, 0x07, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x22, 0x0a,
+	0x0c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x22, 0x4e, 0x0a, 0x08, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x61, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x61, 0x62, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x22, 0x0a, 0x08, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x29, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0x11, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x63,
+	0x6b, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x69, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x65, 0x0a, 0x0a, 0x53, 0x65,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x74, 0x69, 0x63, 0x6b, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x32, 0xde, 0x05, 0x0a, 0x05, 0x45, 0x73, 0x69, 0x76, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x54,
+	0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x43, 0x68,
+	0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x43, 0x68, 0x61,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x14,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x56, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x0d,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x23,
+	0x0a, 0x03, 0x53, 0x61, 0x79, 0x12, 0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x61, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x61, 0x79, 0x52, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x0e, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x26,
+	0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2a, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x12, 0x0f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x11, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x11,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69,
+	0x6c, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a,
+	0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x32, 0xbb, 0x03, 0x0a, 0x0a, 0x45, 0x73, 0x69, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x41,
+	0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x22,
+	0x00, 0x12, 0x32, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x11, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x26, 0x0a, 0x04, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x0d, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a,
+	0x09, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x22, 0x00, 0x12, 0x29, 0x0a, 0x05, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x0e, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x10, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x64, 0x65, 0x2d, 0x63, 0x65, 0x6c, 0x6c, 0x2f, 0x65, 0x73, 0x69, 0x76, 0x65, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_all_proto_rawDescOnce sync.Once
+	file_all_proto_rawDescData = file_all_proto_rawDesc
+)
+
+func file_all_proto_rawDescGZIP() []byte {
+	file_all_proto_rawDescOnce.Do(func() {
+		file_all_proto_rawDescData = protoimpl.X.CompressGZIP(file_all_proto_rawDescData)
+	})
+	return file_all_proto_rawDescData
+}
+
+var file_all_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_all_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_all_proto_goTypes = []interface{}{
+	(VisibilityUpdate_Action)(0), // 0: grpc.VisibilityUpdate.Action
+	(ChatMessage_Kind)(0),        // 1: grpc.ChatMessage.Kind
+	(*TickUpdatesReq)(nil),       // 2: grpc.TickUpdatesReq
+	(*TickUpdatesRes)(nil),       // 3: grpc.TickUpdatesRes
+	(*VisibilityUpdate)(nil),     // 4: grpc.VisibilityUpdate
+	(*SessionReq)(nil),           // 5: grpc.SessionReq
+	(*ResyncReq)(nil),            // 6: grpc.ResyncReq
+	(*SessionRes)(nil),           // 7: grpc.SessionRes
+	(*SessionAck)(nil),           // 8: grpc.SessionAck
+	(*TickSync)(nil),             // 9: grpc.TickSync
+	(*ChatUpdatesReq)(nil),       // 10: grpc.ChatUpdatesReq
+	(*ChatUpdatesRes)(nil),       // 11: grpc.ChatUpdatesRes
+	(*ChatHistoryReq)(nil),       // 12: grpc.ChatHistoryReq
+	(*ChatHistoryRes)(nil),       // 13: grpc.ChatHistoryRes
+	(*MoveReq)(nil),              // 14: grpc.MoveReq
+	(*MoveRes)(nil),              // 15: grpc.MoveRes
+	(*ReadReq)(nil),              // 16: grpc.ReadReq
+	(*ReadRes)(nil),              // 17: grpc.ReadRes
+	(*LoginReq)(nil),             // 18: grpc.LoginReq
+	(*LoginRes)(nil),             // 19: grpc.LoginRes
+	(*JoinReq)(nil),              // 20: grpc.JoinReq
+	(*JoinRes)(nil),              // 21: grpc.JoinRes
+	(*ResumeReq)(nil),            // 22: grpc.ResumeReq
+	(*SayReq)(nil),               // 23: grpc.SayReq
+	(*SayRes)(nil),               // 24: grpc.SayRes
+	(*CompleteCommandReq)(nil),   // 25: grpc.CompleteCommandReq
+	(*CompleteCommandRes)(nil),   // 26: grpc.CompleteCommandRes
+	(*Mail)(nil),                 // 27: grpc.Mail
+	(*ListMailReq)(nil),          // 28: grpc.ListMailReq
+	(*ListMailRes)(nil),          // 29: grpc.ListMailRes
+	(*ReadMailReq)(nil),          // 30: grpc.ReadMailReq
+	(*ReadMailRes)(nil),          // 31: grpc.ReadMailRes
+	(*DeleteMailReq)(nil),        // 32: grpc.DeleteMailReq
+	(*DeleteMailRes)(nil),        // 33: grpc.DeleteMailRes
+	(*Renderable)(nil),           // 34: grpc.Renderable
+	(*RenderableDelta)(nil),      // 35: grpc.RenderableDelta
+	(*Appearance)(nil),           // 36: grpc.Appearance
+	(*Light)(nil),                // 37: grpc.Light
+	(*ChatMessage)(nil),          // 38: grpc.ChatMessage
+	(*Position)(nil),             // 39: grpc.Position
+	(*Velocity)(nil),             // 40: grpc.Velocity
+	(*Player)(nil),               // 41: grpc.Player
+	(*ListPlayersReq)(nil),       // 42: grpc.ListPlayersReq
+	(*ListPlayersRes)(nil),       // 43: grpc.ListPlayersRes
+	(*InspectEntityReq)(nil),     // 44: grpc.InspectEntityReq
+	(*InspectEntityRes)(nil),     // 45: grpc.InspectEntityRes
+	(*TeleportReq)(nil),          // 46: grpc.TeleportReq
+	(*TeleportRes)(nil),          // 47: grpc.TeleportRes
+	(*KickReq)(nil),              // 48: grpc.KickReq
+	(*KickRes)(nil),              // 49: grpc.KickRes
+	(*BroadcastReq)(nil),         // 50: grpc.BroadcastReq
+	(*BroadcastRes)(nil),         // 51: grpc.BroadcastRes
+	(*SpawnReq)(nil),             // 52: grpc.SpawnReq
+	(*SpawnRes)(nil),             // 53: grpc.SpawnRes
+	(*DeleteEntityReq)(nil),      // 54: grpc.DeleteEntityReq
+	(*DeleteEntityRes)(nil),      // 55: grpc.DeleteEntityRes
+	(*SetTickReq)(nil),           // 56: grpc.SetTickReq
+	(*SetTickRes)(nil),           // 57: grpc.SetTickRes
+	nil,                          // 58: grpc.InspectEntityRes.ComponentsEntry
+}
+var file_all_proto_depIdxs = []int32{
+	4,  // 0: grpc.TickUpdatesRes.visibilityUpdates:type_name -> grpc.VisibilityUpdate
+	34, // 1: grpc.VisibilityUpdate.renderable:type_name -> grpc.Renderable
+	0,  // 2: grpc.VisibilityUpdate.action:type_name -> grpc.VisibilityUpdate.Action
+	35, // 3: grpc.VisibilityUpdate.delta:type_name -> grpc.RenderableDelta
+	40, // 4: grpc.SessionReq.velocity:type_name -> grpc.Velocity
+	23, // 5: grpc.SessionReq.say:type_name -> grpc.SayReq
+	16, // 6: grpc.SessionReq.read:type_name -> grpc.ReadReq
+	6,  // 7: grpc.SessionReq.resync:type_name -> grpc.ResyncReq
+	3,  // 8: grpc.SessionRes.tick_updates:type_name -> grpc.TickUpdatesRes
+	38, // 9: grpc.SessionRes.chat:type_name -> grpc.ChatMessage
+	8,  // 10: grpc.SessionRes.ack:type_name -> grpc.SessionAck
+	9,  // 11: grpc.SessionRes.tick_sync:type_name -> grpc.TickSync
+	38, // 12: grpc.ChatUpdatesRes.message:type_name -> grpc.ChatMessage
+	38, // 13: grpc.ChatHistoryRes.messages:type_name -> grpc.ChatMessage
+	39, // 14: grpc.ReadReq.position:type_name -> grpc.Position
+	27, // 15: grpc.ListMailRes.mails:type_name -> grpc.Mail
+	27, // 16: grpc.ReadMailRes.mail:type_name -> grpc.Mail
+	39, // 17: grpc.Renderable.position:type_name -> grpc.Position
+	40, // 18: grpc.Renderable.velocity:type_name -> grpc.Velocity
+	37, // 19: grpc.Renderable.light:type_name -> grpc.Light
+	39, // 20: grpc.RenderableDelta.position:type_name -> grpc.Position
+	40, // 21: grpc.RenderableDelta.velocity:type_name -> grpc.Velocity
+	36, // 22: grpc.RenderableDelta.appearance:type_name -> grpc.Appearance
+	37, // 23: grpc.Appearance.light:type_name -> grpc.Light
+	1,  // 24: grpc.ChatMessage.kind:type_name -> grpc.ChatMessage.Kind
+	39, // 25: grpc.Player.position:type_name -> grpc.Position
+	41, // 26: grpc.ListPlayersRes.players:type_name -> grpc.Player
+	58, // 27: grpc.InspectEntityRes.components:type_name -> grpc.InspectEntityRes.ComponentsEntry
+	39, // 28: grpc.TeleportReq.position:type_name -> grpc.Position
+	39, // 29: grpc.SpawnReq.position:type_name -> grpc.Position
+	2,  // 30: grpc.Esive.TickUpdates:input_type -> grpc.TickUpdatesReq
+	10, // 31: grpc.Esive.ChatUpdates:input_type -> grpc.ChatUpdatesReq
+	12, // 32: grpc.Esive.ChatHistory:input_type -> grpc.ChatHistoryReq
+	40, // 33: grpc.Esive.SetVelocity:input_type -> grpc.Velocity
+	16, // 34: grpc.Esive.Read:input_type -> grpc.ReadReq
+	23, // 35: grpc.Esive.Say:input_type -> grpc.SayReq
+	18, // 36: grpc.Esive.Login:input_type -> grpc.LoginReq
+	20, // 37: grpc.Esive.Join:input_type -> grpc.JoinReq
+	22, // 38: grpc.Esive.Resume:input_type -> grpc.ResumeReq
+	25, // 39: grpc.Esive.CompleteCommand:input_type -> grpc.CompleteCommandReq
+	28, // 40: grpc.Esive.ListMail:input_type -> grpc.ListMailReq
+	30, // 41: grpc.Esive.ReadMail:input_type -> grpc.ReadMailReq
+	32, // 42: grpc.Esive.DeleteMail:input_type -> grpc.DeleteMailReq
+	5,  // 43: grpc.Esive.Session:input_type -> grpc.SessionReq
+	42, // 44: grpc.EsiveAdmin.ListPlayers:input_type -> grpc.ListPlayersReq
+	44, // 45: grpc.EsiveAdmin.InspectEntity:input_type -> grpc.InspectEntityReq
+	46, // 46: grpc.EsiveAdmin.Teleport:input_type -> grpc.TeleportReq
+	48, // 47: grpc.EsiveAdmin.Kick:input_type -> grpc.KickReq
+	50, // 48: grpc.EsiveAdmin.Broadcast:input_type -> grpc.BroadcastReq
+	52, // 49: grpc.EsiveAdmin.Spawn:input_type -> grpc.SpawnReq
+	54, // 50: grpc.EsiveAdmin.DeleteEntity:input_type -> grpc.DeleteEntityReq
+	56, // 51: grpc.EsiveAdmin.SetTick:input_type -> grpc.SetTickReq
+	3,  // 52: grpc.Esive.TickUpdates:output_type -> grpc.TickUpdatesRes
+	11, // 53: grpc.Esive.ChatUpdates:output_type -> grpc.ChatUpdatesRes
+	13, // 54: grpc.Esive.ChatHistory:output_type -> grpc.ChatHistoryRes
+	15, // 55: grpc.Esive.SetVelocity:output_type -> grpc.MoveRes
+	17, // 56: grpc.Esive.Read:output_type -> grpc.ReadRes
+	24, // 57: grpc.Esive.Say:output_type -> grpc.SayRes
+	19, // 58: grpc.Esive.Login:output_type -> grpc.LoginRes
+	21, // 59: grpc.Esive.Join:output_type -> grpc.JoinRes
+	21, // 60: grpc.Esive.Resume:output_type -> grpc.JoinRes
+	26, // 61: grpc.Esive.CompleteCommand:output_type -> grpc.CompleteCommandRes
+	29, // 62: grpc.Esive.ListMail:output_type -> grpc.ListMailRes
+	31, // 63: grpc.Esive.ReadMail:output_type -> grpc.ReadMailRes
+	33, // 64: grpc.Esive.DeleteMail:output_type -> grpc.DeleteMailRes
+	7,  // 65: grpc.Esive.Session:output_type -> grpc.SessionRes
+	43, // 66: grpc.EsiveAdmin.ListPlayers:output_type -> grpc.ListPlayersRes
+	45, // 67: grpc.EsiveAdmin.InspectEntity:output_type -> grpc.InspectEntityRes
+	47, // 68: grpc.EsiveAdmin.Teleport:output_type -> grpc.TeleportRes
+	49, // 69: grpc.EsiveAdmin.Kick:output_type -> grpc.KickRes
+	51, // 70: grpc.EsiveAdmin.Broadcast:output_type -> grpc.BroadcastRes
+	53, // 71: grpc.EsiveAdmin.Spawn:output_type -> grpc.SpawnRes
+	55, // 72: grpc.EsiveAdmin.DeleteEntity:output_type -> grpc.DeleteEntityRes
+	57, // 73: grpc.EsiveAdmin.SetTick:output_type -> grpc.SetTickRes
+	52, // [52:74] is the sub-list for method output_type
+	30, // [30:52] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_all_proto_init() }
+func file_all_proto_init() {
+	if File_all_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_all_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickUpdatesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisibilityUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResyncReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickSync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatUpdatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatUpdatesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatHistoryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SayReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SayRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteCommandReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteCommandRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMailReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMailRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMailReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMailRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMailReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMailRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Renderable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderableDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Appearance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Light); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Velocity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayersRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectEntityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectEntityRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeleportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeleportRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpawnReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpawnRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEntityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEntityRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTickReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_all_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTickRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_all_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*SessionReq_Velocity)(nil),
+		(*SessionReq_Say)(nil),
+		(*SessionReq_Read)(nil),
+		(*SessionReq_Resync)(nil),
+	}
+	file_all_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*SessionRes_TickUpdates)(nil),
+		(*SessionRes_Chat)(nil),
+		(*SessionRes_Ack)(nil),
+		(*SessionRes_TickSync)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_all_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   57,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_all_proto_goTypes,
+		DependencyIndexes: file_all_proto_depIdxs,
+		EnumInfos:         file_all_proto_enumTypes,
+		MessageInfos:      file_all_proto_msgTypes,
+	}.Build()
+	File_all_proto = out.File
+	file_all_proto_rawDesc = nil
+	file_all_proto_goTypes = nil
+	file_all_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EsiveClient is the client API for Esive service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EsiveClient interface {
+	TickUpdates(ctx context.Context, in *TickUpdatesReq, opts ...grpc.CallOption) (Esive_TickUpdatesClient, error)
+	ChatUpdates(ctx context.Context, in *ChatUpdatesReq, opts ...grpc.CallOption) (Esive_ChatUpdatesClient, error)
+	ChatHistory(ctx context.Context, in *ChatHistoryReq, opts ...grpc.CallOption) (*ChatHistoryRes, error)
+	SetVelocity(ctx context.Context, in *Velocity, opts ...grpc.CallOption) (*MoveRes, error)
+	Read(ctx context.Context, in *ReadReq, opts ...grpc.CallOption) (*ReadRes, error)
+	Say(ctx context.Context, in *SayReq, opts ...grpc.CallOption) (*SayRes, error)
+	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRes, error)
+	Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinRes, error)
+	// Reattaches a new connection to a player whose connection dropped. The streams have to be opened again, and
+	// start with the full state.
+	Resume(ctx context.Context, in *ResumeReq, opts ...grpc.CallOption) (*JoinRes, error)
+	CompleteCommand(ctx context.Context, in *CompleteCommandReq, opts ...grpc.CallOption) (*CompleteCommandRes, error)
+	ListMail(ctx context.Context, in *ListMailReq, opts ...grpc.CallOption) (*ListMailRes, error)
+	ReadMail(ctx context.Context, in *ReadMailReq, opts ...grpc.CallOption) (*ReadMailRes, error)
+	DeleteMail(ctx context.Context, in *DeleteMailReq, opts ...grpc.CallOption) (*DeleteMailRes, error)
+	// Carries the input of the player and the events for them on one ordered stream. It does the job of TickUpdates,
+	// ChatUpdates, SetVelocity, Say and Read, which are kept for older clients.
+	Session(ctx context.Context, opts ...grpc.CallOption) (Esive_SessionClient, error)
+}
+
+type esiveClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEsiveClient(cc grpc.ClientConnInterface) EsiveClient {
+	return &esiveClient{cc}
+}
+
+func (c *esiveClient) TickUpdates(ctx context.Context, in *TickUpdatesReq, opts ...grpc.CallOption) (Esive_TickUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Esive_serviceDesc.Streams[0], "/grpc.Esive/TickUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &esiveTickUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Esive_TickUpdatesClient interface {
+	Recv() (*TickUpdatesRes, error)
+	grpc.ClientStream
+}
+
+type esiveTickUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *esiveTickUpdatesClient) Recv() (*TickUpdatesRes, error) {
+	m := new(TickUpdatesRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *esiveClient) ChatUpdates(ctx context.Context, in *ChatUpdatesReq, opts ...grpc.CallOption) (Esive_ChatUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Esive_serviceDesc.Streams[1], "/grpc.Esive/ChatUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &esiveChatUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Esive_ChatUpdatesClient interface {
+	Recv() (*ChatUpdatesRes, error)
+	grpc.ClientStream
+}
+
+type esiveChatUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *esiveChatUpdatesClient) Recv() (*ChatUpdatesRes, error) {
+	m := new(ChatUpdatesRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *esiveClient) ChatHistory(ctx context.Context, in *ChatHistoryReq, opts ...grpc.CallOption) (*ChatHistoryRes, error) {
+	out := new(ChatHistoryRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/ChatHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) SetVelocity(ctx context.Context, in *Velocity, opts ...grpc.CallOption) (*MoveRes, error) {
+	out := new(MoveRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/SetVelocity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) Read(ctx context.Context, in *ReadReq, opts ...grpc.CallOption) (*ReadRes, error) {
+	out := new(ReadRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) Say(ctx context.Context, in *SayReq, opts ...grpc.CallOption) (*SayRes, error) {
+	out := new(SayRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/Say", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRes, error) {
+	out := new(LoginRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinRes, error) {
+	out := new(JoinRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/Join", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) Resume(ctx context.Context, in *ResumeReq, opts ...grpc.CallOption) (*JoinRes, error) {
+	out := new(JoinRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) CompleteCommand(ctx context.Context, in *CompleteCommandReq, opts ...grpc.CallOption) (*CompleteCommandRes, error) {
+	out := new(CompleteCommandRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/CompleteCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) ListMail(ctx context.Context, in *ListMailReq, opts ...grpc.CallOption) (*ListMailRes, error) {
+	out := new(ListMailRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/ListMail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) ReadMail(ctx context.Context, in *ReadMailReq, opts ...grpc.CallOption) (*ReadMailRes, error) {
+	out := new(ReadMailRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/ReadMail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) DeleteMail(ctx context.Context, in *DeleteMailReq, opts ...grpc.CallOption) (*DeleteMailRes, error) {
+	out := new(DeleteMailRes)
+	err := c.cc.Invoke(ctx, "/grpc.Esive/DeleteMail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *esiveClient) Session(ctx context.Context, opts ...grpc.CallOption) (Esive_SessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Esive_serviceDesc.Streams[2], "/grpc.Esive/Session", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &esiveSessionClient{stream}
+	return x, nil
+}
+
+type Esive_SessionClient interface {
+	Send(*SessionReq) error
+	Recv() (*SessionRes, error)
+	grpc.ClientStream
+}
+
+type esiveSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *esiveSessionClient) Send(m *SessionReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *esiveSessionClient) Recv() (*SessionRes, error) {
+	m := new(SessionRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EsiveServer is the server API for Esive service.
+type EsiveServer interface {
+	TickUpdates(*TickUpdatesReq, Esive_TickUpdatesServer) error
+	ChatUpdates(*ChatUpdatesReq, Esive_ChatUpdatesServer) error
+	ChatHistory(context.Context, *ChatHistoryReq) (*ChatHistoryRes, error)
+	SetVelocity(context.Context, *Velocity) (*MoveRes, error)
+	Read(context.Context, *ReadReq) (*ReadRes, error)
+	Say(context.Context, *SayReq) (*SayRes, error)
+	Login(context.Context, *LoginReq) (*LoginRes, error)
+	Join(context.Context, *JoinReq) (*JoinRes, error)
+	// Reattaches a new connection to a player whose connection dropped. The streams have to be opened again, and
+	// start with the full state.
+	Resume(context.Context, *ResumeReq) (*JoinRes, error)
+	CompleteCommand(context.Context, *CompleteCommandReq) (*CompleteCommandRes, error)
+	ListMail(context.Context, *ListMailReq) (*ListMailRes, error)
+	ReadMail(context.Context, *ReadMailReq) (*ReadMailRes, error)
+	DeleteMail(context.Context, *DeleteMailReq) (*DeleteMailRes, error)
+	// Carries the input of the player and the events for them on one ordered stream. It does the job of TickUpdates,
+	// ChatUpdates, SetVelocity, Say and Read, which are kept for older clients.
+	Session(Esive_SessionServer) error
+}
+
+// UnimplementedEsiveServer can be embedded to have forward compatible implementations.
+type UnimplementedEsiveServer struct {
+}
+
+func (*UnimplementedEsiveServer) TickUpdates(*TickUpdatesReq, Esive_TickUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method TickUpdates not implemented")
+}
+func (*UnimplementedEsiveServer) ChatUpdates(*ChatUpdatesReq, Esive_ChatUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ChatUpdates not implemented")
+}
+func (*UnimplementedEsiveServer) ChatHistory(context.Context, *ChatHistoryReq) (*ChatHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChatHistory not implemented")
+}
+func (*UnimplementedEsiveServer) SetVelocity(context.Context, *Velocity) (*MoveRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVelocity not implemented")
+}
+func (*UnimplementedEsiveServer) Read(context.Context, *ReadReq) (*ReadRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (*UnimplementedEsiveServer) Say(context.Context, *SayReq) (*SayRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Say not implemented")
+}
+func (*UnimplementedEsiveServer) Login(context.Context, *LoginReq) (*LoginRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedEsiveServer) Join(context.Context, *JoinReq) (*JoinRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
+}
+func (*UnimplementedEsiveServer) Resume(context.Context, *ResumeReq) (*JoinRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (*UnimplementedEsiveServer) CompleteCommand(context.Context, *CompleteCommandReq) (*CompleteCommandRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCommand not implemented")
+}
+func (*UnimplementedEsiveServer) ListMail(context.Context, *ListMailReq) (*ListMailRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMail not implemented")
+}
+func (*UnimplementedEsiveServer) ReadMail(context.Context, *ReadMailReq) (*ReadMailRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadMail not implemented")
+}
+func (*UnimplementedEsiveServer) DeleteMail(context.Context, *DeleteMailReq) (*DeleteMailRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMail not implemented")
+}
+func (*UnimplementedEsiveServer) Session(Esive_SessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method Session not implemented")
+}
+
+func RegisterEsiveServer(s *grpc.Server, srv EsiveServer) {
+	s.RegisterService(&_Esive_serviceDesc, srv)
+}
+
+func _Esive_TickUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TickUpdatesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EsiveServer).TickUpdates(m, &esiveTickUpdatesServer{stream})
+}
+
+type Esive_TickUpdatesServer interface {
+	Send(*TickUpdatesRes) error
+	grpc.ServerStream
+}
+
+type esiveTickUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *esiveTickUpdatesServer) Send(m *TickUpdatesRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Esive_ChatUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChatUpdatesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EsiveServer).ChatUpdates(m, &esiveChatUpdatesServer{stream})
+}
+
+type Esive_ChatUpdatesServer interface {
+	Send(*ChatUpdatesRes) error
+	grpc.ServerStream
+}
+
+type esiveChatUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *esiveChatUpdatesServer) Send(m *ChatUpdatesRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Esive_ChatHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).ChatHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/ChatHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).ChatHistory(ctx, req.(*ChatHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_SetVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Velocity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).SetVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/SetVelocity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).SetVelocity(ctx, req.(*Velocity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).Read(ctx, req.(*ReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_Say_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SayReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).Say(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/Say",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).Say(ctx, req.(*SayReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).Join(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/Join",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).Join(ctx, req.(*JoinReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).Resume(ctx, req.(*ResumeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_CompleteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteCommandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).CompleteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/CompleteCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).CompleteCommand(ctx, req.(*CompleteCommandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_ListMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).ListMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/ListMail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).ListMail(ctx, req.(*ListMailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_ReadMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadMailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).ReadMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/ReadMail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).ReadMail(ctx, req.(*ReadMailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_DeleteMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveServer).DeleteMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Esive/DeleteMail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveServer).DeleteMail(ctx, req.(*DeleteMailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Esive_Session_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EsiveServer).Session(&esiveSessionServer{stream})
+}
+
+type Esive_SessionServer interface {
+	Send(*SessionRes) error
+	Recv() (*SessionReq, error)
+	grpc.ServerStream
+}
+
+type esiveSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *esiveSessionServer) Send(m *SessionRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *esiveSessionServer) Recv() (*SessionReq, error) {
+	m := new(SessionReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Esive_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc.Esive",
+	HandlerType: (*EsiveServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChatHistory",
+			Handler:    _Esive_ChatHistory_Handler,
+		},
+		{
+			MethodName: "SetVelocity",
+			Handler:    _Esive_SetVelocity_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Esive_Read_Handler,
+		},
+		{
+			MethodName: "Say",
+			Handler:    _Esive_Say_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Esive_Login_Handler,
+		},
+		{
+			MethodName: "Join",
+			Handler:    _Esive_Join_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Esive_Resume_Handler,
+		},
+		{
+			MethodName: "CompleteCommand",
+			Handler:    _Esive_CompleteCommand_Handler,
+		},
+		{
+			MethodName: "ListMail",
+			Handler:    _Esive_ListMail_Handler,
 		},
-		GoTypes:           file_all_proto_goTypes,
-		DependencyIndexes: file_all_proto_depIdxs,
-		EnumInfos:         file_all_proto_enumTypes,
-		MessageInfos:      file_all_proto_msgTypes,
-	}.Build()
-	File_all_proto = out.File
-	file_all_proto_rawDesc = nil
-	file_all_proto_goTypes = nil
-	file_all_proto_depIdxs = nil
+		{
+			MethodName: "ReadMail",
+			Handler:    _Esive_ReadMail_Handler,
+		},
+		{
+			MethodName: "DeleteMail",
+			Handler:    _Esive_DeleteMail_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TickUpdates",
+			Handler:       _Esive_TickUpdates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ChatUpdates",
+			Handler:       _Esive_ChatUpdates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Session",
+			Handler:       _Esive_Session_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "all.proto",
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// EsiveClient is the client API for Esive service.
+// EsiveAdminClient is the client API for EsiveAdmin service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type EsiveClient interface {
-	TickUpdates(ctx context.Context, in *TickUpdatesReq, opts ...grpc.CallOption) (Esive_TickUpdatesClient, error)
-	ChatUpdates(ctx context.Context, in *ChatUpdatesReq, opts ...grpc.CallOption) (Esive_ChatUpdatesClient, error)
-	SetVelocity(ctx context.Context, in *Velocity, opts ...grpc.CallOption) (*MoveRes, error)
-	Read(ctx context.Context, in *ReadReq, opts ...grpc.CallOption) (*ReadRes, error)
-	Say(ctx context.Context, in *SayReq, opts ...grpc.CallOption) (*SayRes, error)
-	Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinRes, error)
-}
-
-type esiveClient struct {
+type EsiveAdminClient interface {
+	ListPlayers(ctx context.Context, in *ListPlayersReq, opts ...grpc.CallOption) (*ListPlayersRes, error)
+	InspectEntity(ctx context.Context, in *InspectEntityReq, opts ...grpc.CallOption) (*InspectEntityRes, error)
+	Teleport(ctx context.Context, in *TeleportReq, opts ...grpc.CallOption) (*TeleportRes, error)
+	Kick(ctx context.Context, in *KickReq, opts ...grpc.CallOption) (*KickRes, error)
+	Broadcast(ctx context.Context, in *BroadcastReq, opts ...grpc.CallOption) (*BroadcastRes, error)
+	Spawn(ctx context.Context, in *SpawnReq, opts ...grpc.CallOption) (*SpawnRes, error)
+	DeleteEntity(ctx context.Context, in *DeleteEntityReq, opts ...grpc.CallOption) (*DeleteEntityRes, error)
+	SetTick(ctx context.Context, in *SetTickReq, opts ...grpc.CallOption) (*SetTickRes, error)
+}
+
+type esiveAdminClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewEsiveClient(cc grpc.ClientConnInterface) EsiveClient {
-	return &esiveClient{cc}
+func NewEsiveAdminClient(cc grpc.ClientConnInterface) EsiveAdminClient {
+	return &esiveAdminClient{cc}
 }
 
-func (c *esiveClient) TickUpdates(ctx context.Context, in *TickUpdatesReq, opts ...grpc.CallOption) (Esive_TickUpdatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Esive_serviceDesc.Streams[0], "/grpc.Esive/TickUpdates", opts...)
+func (c *esiveAdminClient) ListPlayers(ctx context.Context, in *ListPlayersReq, opts ...grpc.CallOption) (*ListPlayersRes, error) {
+	out := new(ListPlayersRes)
+	err := c.cc.Invoke(ctx, "/grpc.EsiveAdmin/ListPlayers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &esiveTickUpdatesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Esive_TickUpdatesClient interface {
-	Recv() (*TickUpdatesRes, error)
-	grpc.ClientStream
-}
-
-type esiveTickUpdatesClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *esiveTickUpdatesClient) Recv() (*TickUpdatesRes, error) {
-	m := new(TickUpdatesRes)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *esiveAdminClient) InspectEntity(ctx context.Context, in *InspectEntityReq, opts ...grpc.CallOption) (*InspectEntityRes, error) {
+	out := new(InspectEntityRes)
+	err := c.cc.Invoke(ctx, "/grpc.EsiveAdmin/InspectEntity", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *esiveClient) ChatUpdates(ctx context.Context, in *ChatUpdatesReq, opts ...grpc.CallOption) (Esive_ChatUpdatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Esive_serviceDesc.Streams[1], "/grpc.Esive/ChatUpdates", opts...)
+func (c *esiveAdminClient) Teleport(ctx context.Context, in *TeleportReq, opts ...grpc.CallOption) (*TeleportRes, error) {
+	out := new(TeleportRes)
+	err := c.cc.Invoke(ctx, "/grpc.EsiveAdmin/Teleport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &esiveChatUpdatesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Esive_ChatUpdatesClient interface {
-	Recv() (*ChatUpdatesRes, error)
-	grpc.ClientStream
-}
-
-type esiveChatUpdatesClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *esiveChatUpdatesClient) Recv() (*ChatUpdatesRes, error) {
-	m := new(ChatUpdatesRes)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *esiveAdminClient) Kick(ctx context.Context, in *KickReq, opts ...grpc.CallOption) (*KickRes, error) {
+	out := new(KickRes)
+	err := c.cc.Invoke(ctx, "/grpc.EsiveAdmin/Kick", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *esiveClient) SetVelocity(ctx context.Context, in *Velocity, opts ...grpc.CallOption) (*MoveRes, error) {
-	out := new(MoveRes)
-	err := c.cc.Invoke(ctx, "/grpc.Esive/SetVelocity", in, out, opts...)
+func (c *esiveAdminClient) Broadcast(ctx context.Context, in *BroadcastReq, opts ...grpc.CallOption) (*BroadcastRes, error) {
+	out := new(BroadcastRes)
+	err := c.cc.Invoke(ctx, "/grpc.EsiveAdmin/Broadcast", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *esiveClient) Read(ctx context.Context, in *ReadReq, opts ...grpc.CallOption) (*ReadRes, error) {
-	out := new(ReadRes)
-	err := c.cc.Invoke(ctx, "/grpc.Esive/Read", in, out, opts...)
+func (c *esiveAdminClient) Spawn(ctx context.Context, in *SpawnReq, opts ...grpc.CallOption) (*SpawnRes, error) {
+	out := new(SpawnRes)
+	err := c.cc.Invoke(ctx, "/grpc.EsiveAdmin/Spawn", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *esiveClient) Say(ctx context.Context, in *SayReq, opts ...grpc.CallOption) (*SayRes, error) {
-	out := new(SayRes)
-	err := c.cc.Invoke(ctx, "/grpc.Esive/Say", in, out, opts...)
+func (c *esiveAdminClient) DeleteEntity(ctx context.Context, in *DeleteEntityReq, opts ...grpc.CallOption) (*DeleteEntityRes, error) {
+	out := new(DeleteEntityRes)
+	err := c.cc.Invoke(ctx, "/grpc.EsiveAdmin/DeleteEntity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *esiveClient) Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinRes, error) {
-	out := new(JoinRes)
-	err := c.cc.Invoke(ctx, "/grpc.Esive/Join", in, out, opts...)
+func (c *esiveAdminClient) SetTick(ctx context.Context, in *SetTickReq, opts ...grpc.CallOption) (*SetTickRes, error) {
+	out := new(SetTickRes)
+	err := c.cc.Invoke(ctx, "/grpc.EsiveAdmin/SetTick", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// EsiveServer is the server API for Esive service.
-type EsiveServer interface {
-	TickUpdates(*TickUpdatesReq, Esive_TickUpdatesServer) error
-	ChatUpdates(*ChatUpdatesReq, Esive_ChatUpdatesServer) error
-	SetVelocity(context.Context, *Velocity) (*MoveRes, error)
-	Read(context.Context, *ReadReq) (*ReadRes, error)
-	Say(context.Context, *SayReq) (*SayRes, error)
-	Join(context.Context, *JoinReq) (*JoinRes, error)
+// EsiveAdminServer is the server API for EsiveAdmin service.
+type EsiveAdminServer interface {
+	ListPlayers(context.Context, *ListPlayersReq) (*ListPlayersRes, error)
+	InspectEntity(context.Context, *InspectEntityReq) (*InspectEntityRes, error)
+	Teleport(context.Context, *TeleportReq) (*TeleportRes, error)
+	Kick(context.Context, *KickReq) (*KickRes, error)
+	Broadcast(context.Context, *BroadcastReq) (*BroadcastRes, error)
+	Spawn(context.Context, *SpawnReq) (*SpawnRes, error)
+	DeleteEntity(context.Context, *DeleteEntityReq) (*DeleteEntityRes, error)
+	SetTick(context.Context, *SetTickReq) (*SetTickRes, error)
 }
 
-// UnimplementedEsiveServer can be embedded to have forward compatible implementations.
-type UnimplementedEsiveServer struct {
+// UnimplementedEsiveAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedEsiveAdminServer struct {
 }
 
-func (*UnimplementedEsiveServer) TickUpdates(*TickUpdatesReq, Esive_TickUpdatesServer) error {
-	return status.Errorf(codes.Unimplemented, "method TickUpdates not implemented")
-}
-func (*UnimplementedEsiveServer) ChatUpdates(*ChatUpdatesReq, Esive_ChatUpdatesServer) error {
-	return status.Errorf(codes.Unimplemented, "method ChatUpdates not implemented")
-}
-func (*UnimplementedEsiveServer) SetVelocity(context.Context, *Velocity) (*MoveRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVelocity not implemented")
+func (*UnimplementedEsiveAdminServer) ListPlayers(context.Context, *ListPlayersReq) (*ListPlayersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlayers not implemented")
 }
-func (*UnimplementedEsiveServer) Read(context.Context, *ReadReq) (*ReadRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+func (*UnimplementedEsiveAdminServer) InspectEntity(context.Context, *InspectEntityReq) (*InspectEntityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectEntity not implemented")
 }
-func (*UnimplementedEsiveServer) Say(context.Context, *SayReq) (*SayRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Say not implemented")
+func (*UnimplementedEsiveAdminServer) Teleport(context.Context, *TeleportReq) (*TeleportRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Teleport not implemented")
 }
-func (*UnimplementedEsiveServer) Join(context.Context, *JoinReq) (*JoinRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
+func (*UnimplementedEsiveAdminServer) Kick(context.Context, *KickReq) (*KickRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Kick not implemented")
 }
-
-func RegisterEsiveServer(s *grpc.Server, srv EsiveServer) {
-	s.RegisterService(&_Esive_serviceDesc, srv)
+func (*UnimplementedEsiveAdminServer) Broadcast(context.Context, *BroadcastReq) (*BroadcastRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
 }
-
-func _Esive_TickUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(TickUpdatesReq)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(EsiveServer).TickUpdates(m, &esiveTickUpdatesServer{stream})
+func (*UnimplementedEsiveAdminServer) Spawn(context.Context, *SpawnReq) (*SpawnRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spawn not implemented")
 }
-
-type Esive_TickUpdatesServer interface {
-	Send(*TickUpdatesRes) error
-	grpc.ServerStream
+func (*UnimplementedEsiveAdminServer) DeleteEntity(context.Context, *DeleteEntityReq) (*DeleteEntityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntity not implemented")
 }
-
-type esiveTickUpdatesServer struct {
-	grpc.ServerStream
+func (*UnimplementedEsiveAdminServer) SetTick(context.Context, *SetTickReq) (*SetTickRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTick not implemented")
 }
 
-func (x *esiveTickUpdatesServer) Send(m *TickUpdatesRes) error {
-	return x.ServerStream.SendMsg(m)
+func RegisterEsiveAdminServer(s *grpc.Server, srv EsiveAdminServer) {
+	s.RegisterService(&_EsiveAdmin_serviceDesc, srv)
 }
 
-func _Esive_ChatUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ChatUpdatesReq)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _EsiveAdmin_ListPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayersReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(EsiveServer).ChatUpdates(m, &esiveChatUpdatesServer{stream})
+	if interceptor == nil {
+		return srv.(EsiveAdminServer).ListPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.EsiveAdmin/ListPlayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveAdminServer).ListPlayers(ctx, req.(*ListPlayersReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Esive_ChatUpdatesServer interface {
-	Send(*ChatUpdatesRes) error
-	grpc.ServerStream
+func _EsiveAdmin_InspectEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectEntityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveAdminServer).InspectEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.EsiveAdmin/InspectEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveAdminServer).InspectEntity(ctx, req.(*InspectEntityReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type esiveChatUpdatesServer struct {
-	grpc.ServerStream
+func _EsiveAdmin_Teleport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeleportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveAdminServer).Teleport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.EsiveAdmin/Teleport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveAdminServer).Teleport(ctx, req.(*TeleportReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *esiveChatUpdatesServer) Send(m *ChatUpdatesRes) error {
-	return x.ServerStream.SendMsg(m)
+func _EsiveAdmin_Kick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EsiveAdminServer).Kick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.EsiveAdmin/Kick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EsiveAdminServer).Kick(ctx, req.(*KickReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Esive_SetVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Velocity)
+func _EsiveAdmin_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EsiveServer).SetVelocity(ctx, in)
+		return srv.(EsiveAdminServer).Broadcast(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/grpc.Esive/SetVelocity",
+		FullMethod: "/grpc.EsiveAdmin/Broadcast",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EsiveServer).SetVelocity(ctx, req.(*Velocity))
+		return srv.(EsiveAdminServer).Broadcast(ctx, req.(*BroadcastReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Esive_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadReq)
+func _EsiveAdmin_Spawn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpawnReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EsiveServer).Read(ctx, in)
+		return srv.(EsiveAdminServer).Spawn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/grpc.Esive/Read",
+		FullMethod: "/grpc.EsiveAdmin/Spawn",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EsiveServer).Read(ctx, req.(*ReadReq))
+		return srv.(EsiveAdminServer).Spawn(ctx, req.(*SpawnReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Esive_Say_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SayReq)
+func _EsiveAdmin_DeleteEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEntityReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EsiveServer).Say(ctx, in)
+		return srv.(EsiveAdminServer).DeleteEntity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/grpc.Esive/Say",
+		FullMethod: "/grpc.EsiveAdmin/DeleteEntity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EsiveServer).Say(ctx, req.(*SayReq))
+		return srv.(EsiveAdminServer).DeleteEntity(ctx, req.(*DeleteEntityReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Esive_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinReq)
+func _EsiveAdmin_SetTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTickReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EsiveServer).Join(ctx, in)
+		return srv.(EsiveAdminServer).SetTick(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/grpc.Esive/Join",
+		FullMethod: "/grpc.EsiveAdmin/SetTick",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EsiveServer).Join(ctx, req.(*JoinReq))
+		return srv.(EsiveAdminServer).SetTick(ctx, req.(*SetTickReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Esive_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "grpc.Esive",
-	HandlerType: (*EsiveServer)(nil),
+var _EsiveAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc.EsiveAdmin",
+	HandlerType: (*EsiveAdminServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "SetVelocity",
-			Handler:    _Esive_SetVelocity_Handler,
+			MethodName: "ListPlayers",
+			Handler:    _EsiveAdmin_ListPlayers_Handler,
 		},
 		{
-			MethodName: "Read",
-			Handler:    _Esive_Read_Handler,
+			MethodName: "InspectEntity",
+			Handler:    _EsiveAdmin_InspectEntity_Handler,
 		},
 		{
-			MethodName: "Say",
-			Handler:    _Esive_Say_Handler,
+			MethodName: "Teleport",
+			Handler:    _EsiveAdmin_Teleport_Handler,
 		},
 		{
-			MethodName: "Join",
-			Handler:    _Esive_Join_Handler,
+			MethodName: "Kick",
+			Handler:    _EsiveAdmin_Kick_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "TickUpdates",
-			Handler:       _Esive_TickUpdates_Handler,
-			ServerStreams: true,
+			MethodName: "Broadcast",
+			Handler:    _EsiveAdmin_Broadcast_Handler,
 		},
 		{
-			StreamName:    "ChatUpdates",
-			Handler:       _Esive_ChatUpdates_Handler,
-			ServerStreams: true,
+			MethodName: "Spawn",
+			Handler:    _EsiveAdmin_Spawn_Handler,
+		},
+		{
+			MethodName: "DeleteEntity",
+			Handler:    _EsiveAdmin_DeleteEntity_Handler,
+		},
+		{
+			MethodName: "SetTick",
+			Handler:    _EsiveAdmin_SetTick_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "all.proto",
 }
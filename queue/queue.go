@@ -31,6 +31,16 @@ func (q *Queue) Connect() error {
 	return nil
 }
 
+// Connected returns whether the connection to NATS is up.
+func (q *Queue) Connected() bool {
+	return q.nc != nil && q.nc.IsConnected()
+}
+
+// Close drops the connection. Consumers stop once it's closed.
+func (q *Queue) Close() {
+	q.nc.Close()
+}
+
 func (q *Queue) HandleTick(ctx context.Context, tick int64) {
 	t := &Tick{
 		Tick: tick,
@@ -107,6 +117,9 @@ func (q *Queue) Consume(subject, consumer string, message proto.Message, cb func
 			if err == nats.ErrTimeout {
 				continue
 			}
+			if err == nats.ErrConnectionClosed || err == nats.ErrBadSubscription {
+				return
+			}
 			panic(err)
 		}
 
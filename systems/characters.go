@@ -0,0 +1,73 @@
+package systems
+
+import (
+	"context"
+
+	components "github.com/code-cell/esive/components"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+)
+
+var charactersTracer = otel.Tracer("systems/characters")
+
+// DetachCharacter takes a player out of the world, keeping everything needed to put it back with
+// RestoreCharacter. The rest of its components, like its name or role, stay.
+func DetachCharacter(parentContext context.Context, entity components.Entity) error {
+	ctx, span := charactersTracer.Start(parentContext, "characters.Detach")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	character := &components.Character{
+		Position:    &components.Position{},
+		Moveable:    &components.Moveable{},
+		Render:      &components.Render{},
+		Looker:      &components.Looker{},
+		Speaker:     &components.Speaker{},
+		LightSource: &components.LightSource{},
+	}
+	worldComponents := []proto.Message{character.Position, character.Moveable, character.Render, character.Looker, character.Speaker, character.LightSource}
+	if err := registry.LoadComponents(ctx, entity, worldComponents...); err != nil {
+		return err
+	}
+	// It stands still when it comes back.
+	character.Moveable.VelX = 0
+	character.Moveable.VelY = 0
+	if err := registry.UpdateComponents(ctx, entity, character); err != nil {
+		return err
+	}
+	for _, component := range worldComponents {
+		if err := registry.DeleteComponent(ctx, entity, component); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreCharacter puts back in the world a player taken out with DetachCharacter. It returns false if it wasn't
+// detached.
+func RestoreCharacter(parentContext context.Context, entity components.Entity) (bool, error) {
+	ctx, span := charactersTracer.Start(parentContext, "characters.Restore")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	character := &components.Character{}
+	if err := registry.LoadComponents(ctx, entity, character); err != nil {
+		return false, err
+	}
+	if character.Position == nil {
+		return false, nil
+	}
+	worldComponents := []proto.Message{character.Position, character.Moveable, character.Render, character.Looker, character.Speaker}
+	if character.LightSource.GetRadius() > 0 {
+		worldComponents = append(worldComponents, character.LightSource)
+	}
+	if err := registry.CreateComponents(ctx, entity, worldComponents...); err != nil {
+		return false, err
+	}
+	return true, registry.DeleteComponent(ctx, entity, character)
+}
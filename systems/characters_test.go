@@ -0,0 +1,51 @@
+package systems
+
+import (
+	"context"
+	"testing"
+
+	components "github.com/code-cell/esive/components"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharacters_DetachRestore(t *testing.T) {
+	env := Setup(t)
+	entity, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), entity,
+		&components.Named{Name: "alice"},
+		&components.Position{X: 3, Y: 4},
+		&components.Moveable{VelX: 1},
+		&components.Render{Char: "@"},
+		&components.Looker{Radius: 10},
+		&components.Speaker{Range: 10},
+	))
+	require.NoError(t, SetRole(context.Background(), entity, PermissionModerator))
+
+	require.NoError(t, DetachCharacter(context.Background(), entity))
+	entities, _, _, err := env.geo.FindInRange(context.Background(), 3, 4, 1)
+	require.NoError(t, err)
+	require.NotContains(t, entities, entity, "it isn't in the world anymore")
+	permission, err := PermissionOf(context.Background(), entity)
+	require.NoError(t, err)
+	require.Equal(t, PermissionModerator, permission, "it keeps the rest of its components")
+
+	restored, err := RestoreCharacter(context.Background(), entity)
+	require.NoError(t, err)
+	require.True(t, restored)
+	pos := &components.Position{}
+	mov := &components.Moveable{}
+	looker := &components.Looker{}
+	require.NoError(t, env.registry.LoadComponents(context.Background(), entity, pos, mov, looker))
+	require.Equal(t, int64(3), pos.X)
+	require.Equal(t, int64(4), pos.Y)
+	require.Equal(t, int64(0), mov.VelX, "it stands still when it comes back")
+	require.Equal(t, float32(10), looker.Radius)
+	entities, _, _, err = env.geo.FindInRange(context.Background(), 3, 4, 1)
+	require.NoError(t, err)
+	require.Contains(t, entities, entity)
+
+	restored, err = RestoreCharacter(context.Background(), entity)
+	require.NoError(t, err)
+	require.False(t, restored, "it isn't detached anymore")
+}
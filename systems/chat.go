@@ -2,21 +2,57 @@ package systems
 
 import (
 	"context"
-	"strings"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/code-cell/esive/actions"
 	components "github.com/code-cell/esive/components"
+	"github.com/code-cell/esive/tick"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
 var chatTracer = otel.Tracer("systems/chat")
 
+// Channels every player is in. Any other channel has to be joined first. System messages have no channel.
+const (
+	ChannelLocal   = "local"
+	ChannelGlobal  = "global"
+	ChannelWhisper = "whisper"
+)
+
+var ErrNotInChannel = errors.New("Not in the channel")
+
+// ChatKind tells how a message has to be shown.
+type ChatKind int
+
+const (
+	ChatKindPlayer ChatKind = iota
+	// ChatKindSystem messages come from the server, like command replies. They have no sender.
+	ChatKindSystem
+	ChatKindEmote
+	ChatKindWhisper
+	// ChatKindError messages are system messages about something that went wrong.
+	ChatKindError
+)
+
 type ChatMessage struct {
+	Kind     ChatKind
 	From     components.Entity
 	FromName string
 	Message  string
+	Channel  string
+	// ToName is only set for whispers.
+	ToName string
+	// Timestamp is the unix time in milliseconds.
+	Timestamp int64
+	// Tick is the server tick when the message was sent.
+	Tick int64
+	// Id orders the logged messages, even the ones that share a timestamp. It's 0 for messages that weren't logged.
+	Id int64
 }
 
 type ChatListener interface {
@@ -28,20 +64,48 @@ type ChatSystem struct {
 	registry       *components.Registry
 	movementSystem *MovementSystem
 
-	commands *ChatCommands
+	commands  *ChatCommands
+	chatLog   *components.ChatLog
+	moderator *ChatModerator
+	tick      *tick.Tick
 
 	listeners    map[components.Entity]ChatListener
+	names        map[string]components.Entity
 	listenersMtx sync.Mutex
+
+	// channels has the members of every named channel. Empty channels are removed.
+	channels    map[string]map[components.Entity]struct{}
+	channelsMtx sync.Mutex
+
+	// blocked caches the BlockList components of the listeners.
+	blocked    map[components.Entity]map[string]struct{}
+	blockedMtx sync.Mutex
 }
 
-func NewChatSystem(actionQueue *actions.ActionsQueue, movementSystem *MovementSystem, registry *components.Registry) *ChatSystem {
-	return &ChatSystem{
+func NewChatSystem(actionQueue *actions.ActionsQueue, movementSystem *MovementSystem, registry *components.Registry, chatLog *components.ChatLog) *ChatSystem {
+	s := &ChatSystem{
 		actionQueue:    actionQueue,
 		movementSystem: movementSystem,
 		registry:       registry,
-		commands:       NewChatCommands("<SYSTEM>", actionQueue, movementSystem, registry),
+		chatLog:        chatLog,
 		listeners:      map[components.Entity]ChatListener{},
+		names:          map[string]components.Entity{},
+		channels:       map[string]map[components.Entity]struct{}{},
+		blocked:        map[components.Entity]map[string]struct{}{},
 	}
+	s.commands = NewChatCommands(s, actionQueue, movementSystem, registry)
+	return s
+}
+
+// SetTick makes messages carry the current tick of t.
+func (s *ChatSystem) SetTick(t *tick.Tick) {
+	s.tick = t
+}
+
+// SetModerator makes every message that reaches other players go through the moderator before being sent.
+// Commands themselves aren't moderated, only what they send.
+func (s *ChatSystem) SetModerator(moderator *ChatModerator) {
+	s.moderator = moderator
 }
 
 func (s *ChatSystem) Say(parentContext context.Context, tick int64, entity components.Entity, text string) error {
@@ -53,56 +117,685 @@ func (s *ChatSystem) Say(parentContext context.Context, tick int64, entity compo
 		attribute.Int64("entity_id", int64(entity)),
 	)
 	defer span.End()
-	if text[0] == '/' {
-		s.listenersMtx.Lock()
-		listener, ok := s.listeners[entity]
-		s.listenersMtx.Unlock()
-		if !ok {
-			// TODO: This shouldn't happen. Maybe log an error message?
-			return nil
-		}
-
-		parts := strings.Split(text[1:], " ")
-		cmd := parts[0]
-		command, found := s.commands.Commands[cmd]
-		if !found {
-			listener.HandleChatMessage(&ChatMessage{
-				FromName: s.commands.systemSender,
-				Message:  "Unknown command. Use `/help` to see the full list.",
-			})
-			return nil
-		}
-		command.Action(ctx, tick, entity, listener, parts[1:])
+
+	s.listenersMtx.Lock()
+	listener, ok := s.listeners[entity]
+	s.listenersMtx.Unlock()
+	if !ok {
+		// TODO: This shouldn't happen. Maybe log an error message?
 		return nil
 	}
+	// The messages only for the player are kept with its whispers.
+	listener = &privateLogListener{ctx: ctx, chat: s, entity: entity, listener: listener}
+
+	if text[0] == '/' {
+		return s.commands.Run(ctx, tick, entity, listener, text[1:])
+	}
+	return s.SayLocal(ctx, entity, text)
+}
+
+// SetMailbox lets players send mail with `/mail`. The accounts are used to find the characters of the players mail is
+// sent to, who may be offline, to check if they blocked the sender.
+func (s *ChatSystem) SetMailbox(mailbox *components.Mailbox, accounts *components.Accounts) {
+	s.commands.mailbox = mailbox
+	s.commands.accounts = accounts
+}
+
+// SetNoteSystem makes the `/note` command use the given notes, with their limits.
+func (s *ChatSystem) SetNoteSystem(notes *NoteSystem) {
+	s.commands.notes = notes
+}
+
+// Commands returns the chat commands, so more can be added.
+func (s *ChatSystem) Commands() *ChatCommands {
+	return s.commands
+}
+
+// Complete returns the ways to complete a partial command line.
+func (s *ChatSystem) Complete(parentContext context.Context, entity components.Entity, line string) ([]string, error) {
+	ctx, span := chatTracer.Start(parentContext, "chat.Complete")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	return s.commands.Complete(ctx, entity, line)
+}
+
+// PlayerNames returns the names of the players listening, sorted.
+func (s *ChatSystem) PlayerNames() []string {
+	s.listenersMtx.Lock()
+	defer s.listenersMtx.Unlock()
+	res := make([]string, 0, len(s.names))
+	for name := range s.names {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// SayLocal sends a message to the players within the Speaker range.
+func (s *ChatSystem) SayLocal(parentContext context.Context, entity components.Entity, text string) error {
+	return s.sayLocal(parentContext, entity, text, ChatKindPlayer)
+}
+
+// Emote tells the players within the Speaker range what the entity does. Eg: `alice waves`.
+func (s *ChatSystem) Emote(parentContext context.Context, entity components.Entity, text string) error {
+	return s.sayLocal(parentContext, entity, text, ChatKindEmote)
+}
+
+func (s *ChatSystem) sayLocal(parentContext context.Context, entity components.Entity, text string, kind ChatKind) error {
+	ctx, span := chatTracer.Start(parentContext, "chat.SayLocal")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	text, err := s.moderate(ctx, entity, text)
+	if err != nil || text == "" {
+		return err
+	}
+
 	speakerPos := &components.Position{}
 	speaker := &components.Speaker{}
 	name := &components.Named{}
-	err := registry.LoadComponents(ctx, entity, speakerPos, speaker, name)
-	if err != nil {
+	if err := registry.LoadComponents(ctx, entity, speakerPos, speaker, name); err != nil {
 		return err
 	}
 
 	chatMessage := &ChatMessage{
-		From:     entity,
-		FromName: name.Name,
-		Message:  text,
+		Kind:      kind,
+		From:      entity,
+		FromName:  name.Name,
+		Message:   text,
+		Channel:   ChannelLocal,
+		Timestamp: now(),
+	}
+	chunkX, chunkY := geo.Chunk(speakerPos.X, speakerPos.Y)
+	if err := s.log(ctx, chatMessage, localLog(components.Chunk{X: chunkX, Y: chunkY})); err != nil {
+		return err
 	}
 	entities, _, _, err := geo.FindInRange(ctx, speakerPos.X, speakerPos.Y, speaker.Range)
+	if err != nil {
+		return err
+	}
+	s.send(ctx, chatMessage, entities...)
+	return nil
+}
+
+// SayGlobal sends a message to every player.
+func (s *ChatSystem) SayGlobal(parentContext context.Context, entity components.Entity, text string) error {
+	ctx, span := chatTracer.Start(parentContext, "chat.SayGlobal")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	text, err := s.moderate(ctx, entity, text)
+	if err != nil || text == "" {
+		return err
+	}
+
+	name := &components.Named{}
+	if err := registry.LoadComponents(ctx, entity, name); err != nil {
+		return err
+	}
+
+	s.listenersMtx.Lock()
+	entities := make([]components.Entity, 0, len(s.listeners))
+	for listenerEntity := range s.listeners {
+		entities = append(entities, listenerEntity)
+	}
+	s.listenersMtx.Unlock()
+
+	chatMessage := &ChatMessage{
+		From:      entity,
+		FromName:  name.Name,
+		Message:   text,
+		Channel:   ChannelGlobal,
+		Timestamp: now(),
+	}
+	if err := s.log(ctx, chatMessage, globalLog); err != nil {
+		return err
+	}
+	s.send(ctx, chatMessage, entities...)
+	return nil
+}
+
+// Broadcast sends a system message to every player.
+func (s *ChatSystem) Broadcast(parentContext context.Context, text string) error {
+	ctx, span := chatTracer.Start(parentContext, "chat.Broadcast")
+	defer span.End()
+
+	s.listenersMtx.Lock()
+	entities := make([]components.Entity, 0, len(s.listeners))
+	for listenerEntity := range s.listeners {
+		entities = append(entities, listenerEntity)
+	}
+	s.listenersMtx.Unlock()
+
+	chatMessage := &ChatMessage{
+		Kind:      ChatKindSystem,
+		Message:   text,
+		Timestamp: now(),
+	}
+	if err := s.log(ctx, chatMessage, globalLog); err != nil {
+		return err
+	}
+	s.send(ctx, chatMessage, entities...)
+	return nil
+}
+
+// Notify sends a system message to the player called `name`. It returns false if there is no player with that name.
+func (s *ChatSystem) Notify(parentContext context.Context, name, text string) bool {
+	ctx, span := chatTracer.Start(parentContext, "chat.Notify")
+	defer span.End()
+
+	s.listenersMtx.Lock()
+	entity, found := s.names[name]
+	s.listenersMtx.Unlock()
+	if !found {
+		return false
+	}
+	s.send(ctx, &ChatMessage{
+		Kind:      ChatKindSystem,
+		Message:   text,
+		Timestamp: now(),
+	}, entity)
+	return true
+}
+
+// Whisper sends a message to the player called `to`, and a copy to the sender. It returns false if there is no
+// player with that name.
+func (s *ChatSystem) Whisper(parentContext context.Context, entity components.Entity, to, text string) (bool, error) {
+	ctx, span := chatTracer.Start(parentContext, "chat.Whisper")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+		attribute.String("to", to),
+	)
+	defer span.End()
+
+	s.listenersMtx.Lock()
+	toEntity, found := s.names[to]
+	s.listenersMtx.Unlock()
+	if !found {
+		return false, nil
+	}
+	text, err := s.moderate(ctx, entity, text)
+	if err != nil || text == "" {
+		return true, err
+	}
+
+	name := &components.Named{}
+	if err := registry.LoadComponents(ctx, entity, name); err != nil {
+		return false, err
+	}
+
+	chatMessage := &ChatMessage{
+		Kind:      ChatKindWhisper,
+		From:      entity,
+		FromName:  name.Name,
+		Message:   text,
+		Channel:   ChannelWhisper,
+		ToName:    to,
+		Timestamp: now(),
+	}
+	blocked, err := s.isBlocked(ctx, toEntity, name.Name)
+	if err != nil {
+		return false, err
+	}
+	if blocked {
+		// The sender isn't told, it looks like any other whisper.
+		if err := s.log(ctx, chatMessage, privateLog(entity)); err != nil {
+			return false, err
+		}
+		s.send(ctx, chatMessage, entity)
+		return true, nil
+	}
+	if err := s.log(ctx, chatMessage, privateLog(entity), privateLog(toEntity)); err != nil {
+		return false, err
+	}
+	s.send(ctx, chatMessage, entity, toEntity)
+	return true, nil
+}
+
+// SayChannel sends a message to the members of a named channel. It returns false if the sender isn't one of them.
+func (s *ChatSystem) SayChannel(parentContext context.Context, entity components.Entity, channel, text string) (bool, error) {
+	ctx, span := chatTracer.Start(parentContext, "chat.SayChannel")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+		attribute.String("channel", channel),
+	)
+	defer span.End()
+
+	s.channelsMtx.Lock()
+	members := s.channels[channel]
+	if _, found := members[entity]; !found {
+		s.channelsMtx.Unlock()
+		return false, nil
+	}
+	entities := make([]components.Entity, 0, len(members))
+	for member := range members {
+		entities = append(entities, member)
+	}
+	s.channelsMtx.Unlock()
+
+	text, err := s.moderate(ctx, entity, text)
+	if err != nil || text == "" {
+		return true, err
+	}
+
+	name := &components.Named{}
+	if err := registry.LoadComponents(ctx, entity, name); err != nil {
+		return false, err
+	}
+
+	chatMessage := &ChatMessage{
+		From:      entity,
+		FromName:  name.Name,
+		Message:   text,
+		Channel:   channel,
+		Timestamp: now(),
+	}
+	if err := s.log(ctx, chatMessage, channelLog(channel)); err != nil {
+		return false, err
+	}
+	s.send(ctx, chatMessage, entities...)
+	return true, nil
+}
+
+// JoinChannel adds the entity to a named channel, creating it if needed. The names of the channels everyone is in
+// can't be used.
+func (s *ChatSystem) JoinChannel(entity components.Entity, channel string) bool {
+	if channel == "" || channel == ChannelLocal || channel == ChannelGlobal || channel == ChannelWhisper {
+		return false
+	}
+	s.channelsMtx.Lock()
+	defer s.channelsMtx.Unlock()
+	members, found := s.channels[channel]
+	if !found {
+		members = map[components.Entity]struct{}{}
+		s.channels[channel] = members
+	}
+	members[entity] = struct{}{}
+	return true
+}
+
+// LeaveChannel removes the entity from a named channel. It returns false if it wasn't a member.
+func (s *ChatSystem) LeaveChannel(entity components.Entity, channel string) bool {
+	s.channelsMtx.Lock()
+	defer s.channelsMtx.Unlock()
+	members := s.channels[channel]
+	if _, found := members[entity]; !found {
+		return false
+	}
+	delete(members, entity)
+	if len(members) == 0 {
+		delete(s.channels, channel)
+	}
+	return true
+}
+
+// Channels returns the named channels the entity is in.
+func (s *ChatSystem) Channels(entity components.Entity) []string {
+	s.channelsMtx.Lock()
+	defer s.channelsMtx.Unlock()
+	res := []string{}
+	for channel, members := range s.channels {
+		if _, found := members[entity]; found {
+			res = append(res, channel)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Backlog returns the last messages relevant to the entity: the ones said around it, in the global channel and in
+// the channels it joined, its whispers and the system messages it got. They are sorted from the oldest to the newest.
+func (s *ChatSystem) Backlog(parentContext context.Context, entity components.Entity, limit int) ([]*ChatMessage, error) {
+	ctx, span := chatTracer.Start(parentContext, "chat.Backlog")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	logs, err := s.localLogs(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	logs = append(logs, globalLog, privateLog(entity))
+	for _, channel := range s.Channels(entity) {
+		logs = append(logs, channelLog(channel))
+	}
+	return s.readLogs(ctx, entity, 0, limit, logs...)
+}
+
+// History returns up to `limit` messages of a channel with an id lower than `before`, from the oldest to the newest. The local
+// channel has what was said around the entity, and the whisper channel also has the system messages it got.
+func (s *ChatSystem) History(parentContext context.Context, entity components.Entity, channel string, before int64, limit int) ([]*ChatMessage, error) {
+	ctx, span := chatTracer.Start(parentContext, "chat.History")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+		attribute.String("channel", channel),
+	)
+	defer span.End()
+
+	var logs []string
+	switch channel {
+	case ChannelLocal:
+		var err error
+		logs, err = s.localLogs(ctx, entity)
+		if err != nil {
+			return nil, err
+		}
+	case ChannelGlobal:
+		logs = []string{globalLog}
+	case ChannelWhisper, "":
+		logs = []string{privateLog(entity)}
+	default:
+		s.channelsMtx.Lock()
+		_, member := s.channels[channel][entity]
+		s.channelsMtx.Unlock()
+		if !member {
+			return nil, ErrNotInChannel
+		}
+		logs = []string{channelLog(channel)}
+	}
+	return s.readLogs(ctx, entity, before, limit, logs...)
+}
+
+func (s *ChatSystem) AddListener(entity components.Entity, name string, listener ChatListener) error {
+	s.listenersMtx.Lock()
+	defer s.listenersMtx.Unlock()
+	s.listeners[entity] = listener
+	s.names[name] = entity
+	return nil
+}
+
+// RemoveListener stops sending messages to the entity, and takes it out of every channel.
+func (s *ChatSystem) RemoveListener(entity components.Entity) {
+	s.listenersMtx.Lock()
+	delete(s.listeners, entity)
+	for name, nameEntity := range s.names {
+		if nameEntity == entity {
+			delete(s.names, name)
+		}
+	}
+	s.listenersMtx.Unlock()
+
+	s.channelsMtx.Lock()
+	for channel, members := range s.channels {
+		delete(members, entity)
+		if len(members) == 0 {
+			delete(s.channels, channel)
+		}
+	}
+	s.channelsMtx.Unlock()
+
+	s.blockedMtx.Lock()
+	delete(s.blocked, entity)
+	s.blockedMtx.Unlock()
+}
+
+// log keeps the message in the logs. Messages are stamped with the time and tick when they are logged, if they
+// don't have them yet, and get an id.
+func (s *ChatSystem) log(ctx context.Context, message *ChatMessage, logs ...string) error {
+	if message.Id == 0 {
+		id, err := s.chatLog.NextID(ctx)
+		if err != nil {
+			return err
+		}
+		message.Id = id
+	}
+	if message.Timestamp == 0 {
+		message.Timestamp = now()
+	}
+	if message.Tick == 0 && s.tick != nil {
+		message.Tick = s.tick.Current()
+	}
+	entry := &components.ChatLogEntry{
+		Kind:      int32(message.Kind),
+		From:      int64(message.From),
+		FromName:  message.FromName,
+		Message:   message.Message,
+		Channel:   message.Channel,
+		ToName:    message.ToName,
+		Timestamp: message.Timestamp,
+		Tick:      message.Tick,
+		Id:        message.Id,
+	}
+	for _, log := range logs {
+		if err := s.chatLog.Append(ctx, log, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readLogs merges the logs, returning the last `limit` messages with an id lower than `before` that the entity hasn't
+// blocked. A zero `before` means now.
+func (s *ChatSystem) readLogs(ctx context.Context, entity components.Entity, before int64, limit int, logs ...string) ([]*ChatMessage, error) {
+	blockList, err := s.blockList(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	res := []*ChatMessage{}
+	for _, log := range logs {
+		entries, err := s.chatLog.Read(ctx, log)
+		if err != nil {
+			return nil, err
+		}
+		// Entries are read from the newest, they are added from the oldest so the sort keeps their order.
+		for i := len(entries) - 1; i >= 0; i-- {
+			entry := entries[i]
+			if before != 0 && entry.Id >= before {
+				continue
+			}
+			if _, found := blockList[entry.FromName]; found && components.Entity(entry.From) != entity {
+				continue
+			}
+			res = append(res, &ChatMessage{
+				Kind:      ChatKind(entry.Kind),
+				From:      components.Entity(entry.From),
+				FromName:  entry.FromName,
+				Message:   entry.Message,
+				Channel:   entry.Channel,
+				ToName:    entry.ToName,
+				Timestamp: entry.Timestamp,
+				Tick:      entry.Tick,
+				Id:        entry.Id,
+			})
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Id < res[j].Id
+	})
+	if len(res) > limit {
+		res = res[len(res)-limit:]
+	}
+	return res, nil
+}
+
+// localLogs returns the logs of the chunks the entity can hear.
+func (s *ChatSystem) localLogs(ctx context.Context, entity components.Entity) ([]string, error) {
+	pos := &components.Position{}
+	speaker := &components.Speaker{}
+	if err := registry.LoadComponents(ctx, entity, pos, speaker); err != nil {
+		return nil, err
+	}
+	logs := []string{}
+	for _, chunk := range geo.ChunksInRange(pos.X, pos.Y, speaker.Range) {
+		logs = append(logs, localLog(chunk))
+	}
+	return logs, nil
+}
+
+// Block stops delivering the messages of the player called `name` to the entity.
+func (s *ChatSystem) Block(ctx context.Context, entity components.Entity, name string) error {
+	blockList, err := s.blockList(ctx, entity)
+	if err != nil {
+		return err
+	}
+	blockList[name] = struct{}{}
+	return s.saveBlockList(ctx, entity, blockList)
+}
+
+// Unblock delivers again the messages of the player called `name`. It returns false if it wasn't blocked.
+func (s *ChatSystem) Unblock(ctx context.Context, entity components.Entity, name string) (bool, error) {
+	blockList, err := s.blockList(ctx, entity)
+	if err != nil {
+		return false, err
+	}
+	if _, found := blockList[name]; !found {
+		return false, nil
+	}
+	delete(blockList, name)
+	return true, s.saveBlockList(ctx, entity, blockList)
+}
+
+// Blocked returns the names blocked by the entity, sorted.
+func (s *ChatSystem) Blocked(ctx context.Context, entity components.Entity) ([]string, error) {
+	blockList, err := s.blockList(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	return sortedNames(blockList), nil
+}
+
+// blockList returns a copy of the names blocked by the entity, loading them if they aren't cached yet.
+func (s *ChatSystem) blockList(ctx context.Context, entity components.Entity) (map[string]struct{}, error) {
+	s.blockedMtx.Lock()
+	cached, found := s.blocked[entity]
+	s.blockedMtx.Unlock()
+	if !found {
+		component := &components.BlockList{}
+		if err := registry.LoadComponents(ctx, entity, component); err != nil {
+			return nil, err
+		}
+		cached = map[string]struct{}{}
+		for _, name := range component.Names {
+			cached[name] = struct{}{}
+		}
+		s.blockedMtx.Lock()
+		s.blocked[entity] = cached
+		s.blockedMtx.Unlock()
+	}
+
+	s.blockedMtx.Lock()
+	defer s.blockedMtx.Unlock()
+	res := make(map[string]struct{}, len(cached))
+	for name := range cached {
+		res[name] = struct{}{}
+	}
+	return res, nil
+}
+
+func (s *ChatSystem) saveBlockList(ctx context.Context, entity components.Entity, blockList map[string]struct{}) error {
+	if err := registry.CreateComponents(ctx, entity, &components.BlockList{Names: sortedNames(blockList)}); err != nil {
+		return err
+	}
+	s.blockedMtx.Lock()
+	defer s.blockedMtx.Unlock()
+	s.blocked[entity] = blockList
+	return nil
+}
+
+func (s *ChatSystem) isBlocked(ctx context.Context, entity components.Entity, name string) (bool, error) {
+	blockList, err := s.blockList(ctx, entity)
+	if err != nil {
+		return false, err
+	}
+	_, found := blockList[name]
+	return found, nil
+}
+
+// moderate checks a message before it reaches other players. It returns the text to send, which is empty if the
+// message has to be dropped, and tells the sender if there is anything to tell.
+func (s *ChatSystem) moderate(ctx context.Context, entity components.Entity, text string) (string, error) {
+	if s.moderator == nil {
+		return text, nil
+	}
+	text, notice := s.moderator.Moderate(entity, text)
+	if notice != "" {
+		message := &ChatMessage{
+			Kind:      ChatKindError,
+			Message:   notice,
+			Timestamp: now(),
+		}
+		if err := s.log(ctx, message, privateLog(entity)); err != nil {
+			return "", err
+		}
+		s.send(ctx, message, entity)
+	}
+	return text, nil
+}
+
+// send delivers the message to the entities that are listening and haven't blocked the sender.
+func (s *ChatSystem) send(ctx context.Context, message *ChatMessage, entities ...components.Entity) {
+	listeners := make([]ChatListener, 0, len(entities))
 	for _, entity := range entities {
+		if message.From != 0 && entity != message.From {
+			blocked, err := s.isBlocked(ctx, entity, message.FromName)
+			if err != nil {
+				panic(err)
+			}
+			if blocked {
+				continue
+			}
+		}
 		s.listenersMtx.Lock()
 		listener, ok := s.listeners[entity]
 		s.listenersMtx.Unlock()
 		if ok {
-			listener.HandleChatMessage(chatMessage)
+			listeners = append(listeners, listener)
 		}
 	}
-	return nil
+
+	for _, listener := range listeners {
+		listener.HandleChatMessage(message)
+	}
 }
 
-func (s *ChatSystem) AddListener(entity components.Entity, listener ChatListener) error {
-	s.listenersMtx.Lock()
-	defer s.listenersMtx.Unlock()
-	s.listeners[entity] = listener
-	return nil
+const globalLog = "global"
+
+func localLog(chunk components.Chunk) string {
+	return fmt.Sprintf("local:%d:%d", chunk.X, chunk.Y)
+}
+
+func channelLog(channel string) string {
+	return "channel:" + channel
+}
+
+// privateLog has the whispers and system messages of an entity.
+func privateLog(entity components.Entity) string {
+	return fmt.Sprintf("entity:%d", entity)
+}
+
+func sortedNames(names map[string]struct{}) []string {
+	res := make([]string, 0, len(names))
+	for name := range names {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// now returns the current unix time in milliseconds.
+func now() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// privateLogListener keeps the messages sent directly to a listener in the private log of the entity.
+type privateLogListener struct {
+	ctx      context.Context
+	chat     *ChatSystem
+	entity   components.Entity
+	listener ChatListener
+}
+
+func (l *privateLogListener) HandleChatMessage(message *ChatMessage) {
+	if err := l.chat.log(l.ctx, message, privateLog(l.entity)); err != nil {
+		panic(err)
+	}
+	l.listener.HandleChatMessage(message)
 }
@@ -0,0 +1,117 @@
+package systems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	components "github.com/code-cell/esive/components"
+)
+
+// Permission is the level an entity needs to run a command. Higher levels can run everything lower levels can.
+type Permission int
+
+const (
+	PermissionPlayer Permission = iota
+	PermissionModerator
+	PermissionAdmin
+)
+
+type ChatArgType int
+
+const (
+	// ArgInt is a number.
+	ArgInt ChatArgType = iota
+	// ArgWord is a single word.
+	ArgWord
+	// ArgPlayer is the name of a player. It isn't checked, but it's completed with the names of the connected ones.
+	ArgPlayer
+	// ArgRest takes the rest of the line. It can only be the last argument.
+	ArgRest
+)
+
+type ChatArg struct {
+	Name     string
+	Type     ChatArgType
+	Optional bool
+}
+
+// ChatArgs are the parsed arguments of a command, by name.
+type ChatArgs map[string]interface{}
+
+func (a ChatArgs) Int(name string) int64 {
+	v, _ := a[name].(int64)
+	return v
+}
+
+func (a ChatArgs) String(name string) string {
+	v, _ := a[name].(string)
+	return v
+}
+
+func (a ChatArgs) Has(name string) bool {
+	_, found := a[name]
+	return found
+}
+
+type ChatAction func(context.Context, int64, components.Entity, ChatListener, ChatArgs)
+
+type ChatCommand struct {
+	Command    string
+	Aliases    []string
+	Help       string
+	Args       []ChatArg
+	Permission Permission
+	Action     ChatAction
+}
+
+// Usage returns how the command is used. Eg: `/tp X Y`.
+func (c *ChatCommand) Usage() string {
+	usage := strings.Builder{}
+	usage.WriteString("/")
+	usage.WriteString(c.Command)
+	for _, arg := range c.Args {
+		name := strings.ToUpper(arg.Name)
+		if arg.Type == ArgRest {
+			name += "..."
+		}
+		if arg.Optional {
+			name = "[" + name + "]"
+		}
+		usage.WriteString(" ")
+		usage.WriteString(name)
+	}
+	return usage.String()
+}
+
+// parse converts the words after the command into its arguments.
+func (c *ChatCommand) parse(words []string) (ChatArgs, error) {
+	args := ChatArgs{}
+	for i, arg := range c.Args {
+		if i >= len(words) {
+			if arg.Optional {
+				break
+			}
+			return nil, fmt.Errorf("missing %v", strings.ToUpper(arg.Name))
+		}
+		switch arg.Type {
+		case ArgInt:
+			n, err := strconv.ParseInt(words[i], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%v has to be a number", strings.ToUpper(arg.Name))
+			}
+			args[arg.Name] = n
+		case ArgRest:
+			args[arg.Name] = strings.Join(words[i:], " ")
+			return args, nil
+		default:
+			args[arg.Name] = words[i]
+		}
+	}
+	if len(words) > len(c.Args) {
+		return nil, errors.New("too many arguments")
+	}
+	return args, nil
+}
@@ -0,0 +1,132 @@
+package systems
+
+import (
+	"context"
+	"testing"
+
+	"github.com/code-cell/esive/actions"
+	components "github.com/code-cell/esive/components"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestChatCommand_Parse(t *testing.T) {
+	command := &ChatCommand{
+		Command: "test",
+		Args: []ChatArg{
+			{Name: "n", Type: ArgInt},
+			{Name: "player", Type: ArgPlayer},
+			{Name: "text", Type: ArgRest, Optional: true},
+		},
+	}
+	require.Equal(t, "/test N PLAYER [TEXT...]", command.Usage())
+
+	args, err := command.parse([]string{"12", "alice", "hello", "world"})
+	require.NoError(t, err)
+	require.Equal(t, int64(12), args.Int("n"))
+	require.Equal(t, "alice", args.String("player"))
+	require.Equal(t, "hello world", args.String("text"))
+
+	args, err = command.parse([]string{"12", "alice"})
+	require.NoError(t, err)
+	require.False(t, args.Has("text"))
+
+	_, err = command.parse([]string{"twelve", "alice"})
+	require.EqualError(t, err, "N has to be a number")
+	_, err = command.parse([]string{"12"})
+	require.EqualError(t, err, "missing PLAYER")
+}
+
+func TestChatCommands_Run(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	chat.Commands().AddCommand(&ChatCommand{
+		Command:    "secret",
+		Permission: PermissionAdmin,
+		Action: func(_ context.Context, _ int64, _ components.Entity, listener ChatListener, _ ChatArgs) {
+			listener.HandleChatMessage(&ChatMessage{Message: "ran"})
+		},
+	})
+	alice, listener := newSpeaker(t, env, chat, "alice", 0, 0)
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/tp 1 nope"))
+	require.Equal(t, []string{":You aren't allowed to use /tp."}, listener.received())
+
+	listener.messages = nil
+	require.NoError(t, SetRole(context.Background(), alice, PermissionModerator))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/tp 1 nope"))
+	require.Equal(t, []string{":Invalid syntax: Y has to be a number. Usage: /tp X Y"}, listener.received())
+
+	listener.messages = nil
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/secret"))
+	require.Equal(t, []string{":You aren't allowed to use /secret."}, listener.received())
+
+	listener.messages = nil
+	chat.Commands().PermissionOf = func(context.Context, components.Entity) (Permission, error) {
+		return PermissionAdmin, nil
+	}
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/secret"))
+	require.Equal(t, []string{":ran"}, listener.received())
+}
+
+func TestChatCommands_Complete(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	alice, _ := newSpeaker(t, env, chat, "alice", 0, 0)
+	newSpeaker(t, env, chat, "albert", 0, 0)
+	newSpeaker(t, env, chat, "bob", 0, 0)
+
+	completions, err := chat.Complete(context.Background(), alice, "/bl")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/block ", "/blocked "}, completions)
+
+	completions, err = chat.Complete(context.Background(), alice, "/w al")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/w albert ", "/w alice "}, completions)
+
+	completions, err = chat.Complete(context.Background(), alice, "/msg ")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/msg albert ", "/msg alice ", "/msg bob "}, completions, "aliases work too")
+
+	completions, err = chat.Complete(context.Background(), alice, "/w bob hel")
+	require.NoError(t, err)
+	require.Empty(t, completions)
+
+	completions, err = chat.Complete(context.Background(), alice, "/")
+	require.NoError(t, err)
+	require.Contains(t, completions, "/help ", "a lone slash lists the commands")
+
+	completions, err = chat.Complete(context.Background(), alice, "/ ")
+	require.NoError(t, err)
+	require.Empty(t, completions)
+
+	completions, err = chat.Complete(context.Background(), alice, "/tp  ")
+	require.NoError(t, err)
+	require.Empty(t, completions)
+}
+
+func TestPermissionOf(t *testing.T) {
+	env := Setup(t)
+	entity, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+
+	permission, err := PermissionOf(context.Background(), entity)
+	require.NoError(t, err)
+	require.Equal(t, PermissionPlayer, permission, "entities without a role are players")
+
+	require.NoError(t, SetRole(context.Background(), entity, PermissionAdmin))
+	permission, err = PermissionOf(context.Background(), entity)
+	require.NoError(t, err)
+	require.Equal(t, PermissionAdmin, permission)
+
+	require.NoError(t, SetRole(context.Background(), entity, PermissionPlayer))
+	permission, err = PermissionOf(context.Background(), entity)
+	require.NoError(t, err)
+	require.Equal(t, PermissionPlayer, permission)
+
+	parsed, err := ParsePermission("moderator")
+	require.NoError(t, err)
+	require.Equal(t, PermissionModerator, parsed)
+	_, err = ParsePermission("king")
+	require.EqualError(t, err, "unknown role king")
+}
@@ -4,99 +4,313 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"strconv"
+	"sort"
 	"strings"
 
 	"github.com/code-cell/esive/actions"
 	components "github.com/code-cell/esive/components"
 )
 
-type ChatAction func(context.Context, int64, components.Entity, ChatListener, []string)
-type ChatCommand struct {
-	Command string
-	Help    string
-	Action  ChatAction
-}
-
 type ChatCommands struct {
 	Commands map[string]*ChatCommand
+	aliases  map[string]*ChatCommand
+
+	// PermissionOf returns the permission level of an entity. By default it's the one granted by its Role.
+	PermissionOf func(context.Context, components.Entity) (Permission, error)
 
+	chat        *ChatSystem
+	notes       *NoteSystem
+	mailbox     *components.Mailbox
+	accounts    *components.Accounts
 	actionQueue *actions.ActionsQueue
 	movement    *MovementSystem
 	registry    *components.Registry
-
-	systemSender string
 }
 
-func NewChatCommands(systemSender string, actionQueue *actions.ActionsQueue, movement *MovementSystem, registry *components.Registry) *ChatCommands {
+func NewChatCommands(chat *ChatSystem, actionQueue *actions.ActionsQueue, movement *MovementSystem, registry *components.Registry) *ChatCommands {
 	cm := &ChatCommands{
 		Commands:     make(map[string]*ChatCommand),
+		aliases:      make(map[string]*ChatCommand),
+		PermissionOf: PermissionOf,
+		chat:         chat,
+		notes:        NewNoteSystem(0, 0),
 		actionQueue:  actionQueue,
 		movement:     movement,
 		registry:     registry,
-		systemSender: systemSender,
 	}
 
-	cm.addCommand("help", "Displays this help", cm.helpCommand)
-	cm.addCommand("tp", "Teleports you to the given coordinates. Eg: /tp 0 0", cm.teleportCommand)
-	cm.addCommand("note", "Leaves a note in the world. Eg: /note Hello world!", cm.noteCommand)
+	cm.AddCommand(&ChatCommand{
+		Command: "help",
+		Aliases: []string{"?"},
+		Help:    "Displays this help",
+		Action:  cm.helpCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command:    "tp",
+		Aliases:    []string{"teleport"},
+		Help:       "Teleports you to the given coordinates. Eg: /tp 0 0",
+		Args:       []ChatArg{{Name: "x", Type: ArgInt}, {Name: "y", Type: ArgInt}},
+		Permission: PermissionModerator,
+		Action:     cm.teleportCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "note",
+		Help:    "Leaves a note in the world. Eg: /note Hello world!",
+		Args:    []ChatArg{{Name: "text", Type: ArgRest}},
+		Action:  cm.noteCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "noteedit",
+		Help:    "Changes the text of your note where you are. Eg: /noteedit Hello again!",
+		Args:    []ChatArg{{Name: "text", Type: ArgRest}},
+		Action:  cm.noteEditCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "notedel",
+		Help:    "Removes your note where you are",
+		Action:  cm.noteDeleteCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "w",
+		Aliases: []string{"whisper", "msg"},
+		Help:    "Whispers to a player. Eg: /w alice Hi!",
+		Args:    []ChatArg{{Name: "player", Type: ArgPlayer}, {Name: "text", Type: ArgRest}},
+		Action:  cm.whisperCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "me",
+		Help:    "Tells the players around you what you do. Eg: /me waves",
+		Args:    []ChatArg{{Name: "text", Type: ArgRest}},
+		Action:  cm.emoteCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "mail",
+		Help:    "Sends mail to a player, even if they are offline. Eg: /mail alice See you tomorrow",
+		Args:    []ChatArg{{Name: "player", Type: ArgPlayer}, {Name: "text", Type: ArgRest}},
+		Action:  cm.mailCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "g",
+		Aliases: []string{"global"},
+		Help:    "Says something to everyone. Eg: /g Hello world!",
+		Args:    []ChatArg{{Name: "text", Type: ArgRest}},
+		Action:  cm.globalCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "join",
+		Help:    "Joins a channel. Eg: /join party",
+		Args:    []ChatArg{{Name: "channel", Type: ArgWord}},
+		Action:  cm.joinCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "leave",
+		Help:    "Leaves a channel. Eg: /leave party",
+		Args:    []ChatArg{{Name: "channel", Type: ArgWord}},
+		Action:  cm.leaveCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "c",
+		Aliases: []string{"channel"},
+		Help:    "Says something in a channel you joined. Eg: /c party Hi!",
+		Args:    []ChatArg{{Name: "channel", Type: ArgWord}, {Name: "text", Type: ArgRest}},
+		Action:  cm.channelCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "channels",
+		Help:    "Lists the channels you joined",
+		Action:  cm.channelsCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "block",
+		Help:    "Stops showing you the messages of a player. Eg: /block alice",
+		Args:    []ChatArg{{Name: "player", Type: ArgPlayer}},
+		Action:  cm.blockCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "unblock",
+		Help:    "Shows you again the messages of a player. Eg: /unblock alice",
+		Args:    []ChatArg{{Name: "player", Type: ArgPlayer}},
+		Action:  cm.unblockCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command: "blocked",
+		Help:    "Lists the players you blocked",
+		Action:  cm.blockedCommand,
+	})
+	cm.AddCommand(&ChatCommand{
+		Command:    "broadcast",
+		Help:       "Sends a system message to everyone. Eg: /broadcast The server restarts in 5 minutes",
+		Args:       []ChatArg{{Name: "text", Type: ArgRest}},
+		Permission: PermissionAdmin,
+		Action:     cm.broadcastCommand,
+	})
 
 	return cm
 }
 
-func (cm *ChatCommands) addCommand(command, help string, action ChatAction) {
-	cm.Commands[command] = &ChatCommand{
-		Command: command,
-		Help:    help,
-		Action:  action,
+func (cm *ChatCommands) AddCommand(command *ChatCommand) {
+	cm.Commands[command.Command] = command
+	for _, alias := range command.Aliases {
+		cm.aliases[alias] = command
 	}
 }
 
-func (cm *ChatCommands) helpCommand(_ context.Context, _ int64, _ components.Entity, listener ChatListener, _ []string) {
-	message := bytes.NewBufferString("This is the list of commands:\n")
-	for _, command := range cm.Commands {
-		message.WriteString("  /")
-		message.WriteString(command.Command)
-		message.WriteString(": ")
-		message.WriteString(command.Help)
-		message.WriteString("\n")
+// Find returns a command by its name or any of its aliases.
+func (cm *ChatCommands) Find(name string) (*ChatCommand, bool) {
+	if command, found := cm.Commands[name]; found {
+		return command, true
 	}
-	listener.HandleChatMessage(&ChatMessage{
-		FromName: cm.systemSender,
-		Message:  message.String(),
-	})
+	command, found := cm.aliases[name]
+	return command, found
 }
 
-func (cm *ChatCommands) teleportCommand(ctx context.Context, tick int64, entity components.Entity, listener ChatListener, args []string) {
-	if len(args) != 2 {
-		listener.HandleChatMessage(&ChatMessage{
-			FromName: cm.systemSender,
-			Message:  "Invalid syntax.",
-		})
-		return
+// Run parses and runs a command line, without the leading `/`. Problems are reported to the listener.
+func (cm *ChatCommands) Run(ctx context.Context, tick int64, entity components.Entity, listener ChatListener, line string) error {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	command, found := cm.Find(words[0])
+	if !found {
+		cm.fail(listener, "Unknown command. Use `/help` to see the full list.")
+		return nil
 	}
 
-	x, err := strconv.ParseInt(args[0], 10, 64)
+	allowed, err := cm.allowed(ctx, entity, command)
 	if err != nil {
-		listener.HandleChatMessage(&ChatMessage{
-			FromName: cm.systemSender,
-			Message:  "Invalid syntax.",
-		})
-		return
+		return err
+	}
+	if !allowed {
+		cm.fail(listener, "You aren't allowed to use /%v.", command.Command)
+		return nil
 	}
-	y, err := strconv.ParseInt(args[1], 10, 64)
+
+	args, err := command.parse(words[1:])
 	if err != nil {
-		listener.HandleChatMessage(&ChatMessage{
-			FromName: cm.systemSender,
-			Message:  "Invalid syntax.",
-		})
-		return
+		cm.fail(listener, "Invalid syntax: %v. Usage: %v", err.Error(), command.Usage())
+		return nil
+	}
+	command.Action(ctx, tick, entity, listener, args)
+	return nil
+}
+
+// Complete returns the ways to complete a partial command line, including the leading `/`. Commands are completed
+// by name and players by the names of the connected ones.
+func (cm *ChatCommands) Complete(ctx context.Context, entity components.Entity, line string) ([]string, error) {
+	if !strings.HasPrefix(line, "/") {
+		return nil, nil
+	}
+	res := []string{}
+	words := strings.Fields(line[1:])
+	if !strings.Contains(line, " ") {
+		partial := line[1:]
+		for name, command := range cm.Commands {
+			allowed, err := cm.allowed(ctx, entity, command)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				continue
+			}
+			for _, candidate := range append([]string{name}, command.Aliases...) {
+				if strings.HasPrefix(candidate, partial) {
+					res = append(res, "/"+candidate+" ")
+				}
+			}
+		}
+		sort.Strings(res)
+		return res, nil
+	}
+
+	if len(words) == 0 {
+		// Only spaces after the slash.
+		return res, nil
+	}
+	command, found := cm.Find(words[0])
+	if !found {
+		return res, nil
+	}
+	argIndex := len(words) - 1
+	partial := ""
+	if strings.HasSuffix(line, " ") {
+		argIndex = len(words)
+	} else {
+		partial = words[len(words)-1]
+	}
+	argIndex-- // The first word is the command
+	if argIndex >= len(command.Args) || command.Args[argIndex].Type != ArgPlayer {
+		return res, nil
+	}
+	prefix := line[:len(line)-len(partial)]
+	for _, name := range cm.chat.PlayerNames() {
+		if strings.HasPrefix(name, partial) {
+			res = append(res, prefix+name+" ")
+		}
 	}
+	sort.Strings(res)
+	return res, nil
+}
+
+func (cm *ChatCommands) allowed(ctx context.Context, entity components.Entity, command *ChatCommand) (bool, error) {
+	if command.Permission == PermissionPlayer {
+		return true, nil
+	}
+	permission, err := cm.PermissionOf(ctx, entity)
+	if err != nil {
+		return false, err
+	}
+	return permission >= command.Permission, nil
+}
 
+func (cm *ChatCommands) reply(listener ChatListener, format string, a ...interface{}) {
 	listener.HandleChatMessage(&ChatMessage{
-		FromName: cm.systemSender,
-		Message:  fmt.Sprintf("Teleporting to [%d %d].", x, y),
+		Kind:    ChatKindSystem,
+		Message: fmt.Sprintf(format, a...),
 	})
+}
+
+// fail replies with an error.
+func (cm *ChatCommands) fail(listener ChatListener, format string, a ...interface{}) {
+	listener.HandleChatMessage(&ChatMessage{
+		Kind:    ChatKindError,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+func (cm *ChatCommands) helpCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, _ ChatArgs) {
+	names := make([]string, 0, len(cm.Commands))
+	for name := range cm.Commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	message := bytes.NewBufferString("This is the list of commands:\n")
+	for _, name := range names {
+		command := cm.Commands[name]
+		allowed, err := cm.allowed(ctx, entity, command)
+		if err != nil {
+			panic(err)
+		}
+		if !allowed {
+			continue
+		}
+		message.WriteString("  ")
+		message.WriteString(command.Usage())
+		message.WriteString(": ")
+		message.WriteString(command.Help)
+		if len(command.Aliases) > 0 {
+			message.WriteString(" (also /")
+			message.WriteString(strings.Join(command.Aliases, ", /"))
+			message.WriteString(")")
+		}
+		message.WriteString("\n")
+	}
+	cm.reply(listener, "%v", message.String())
+}
+
+func (cm *ChatCommands) teleportCommand(ctx context.Context, tick int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	x, y := args.Int("x"), args.Int("y")
+	cm.reply(listener, "Teleporting to [%d %d].", x, y)
 
 	cm.actionQueue.QueueInmediate(ctx, func(ctx context.Context) {
 		pos := &components.Position{}
@@ -104,43 +318,234 @@ func (cm *ChatCommands) teleportCommand(ctx context.Context, tick int64, entity
 		if err != nil {
 			panic(err)
 		}
-		cm.movement.Teleport(ctx, tick, entity, x, y)
+		if err := cm.movement.Teleport(ctx, tick, entity, x, y); err != nil {
+			panic(err)
+		}
 	})
 }
 
-func (cm *ChatCommands) noteCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, args []string) {
-	if len(args) == 0 {
-		listener.HandleChatMessage(&ChatMessage{
-			FromName: cm.systemSender,
-			Message:  "Invalid syntax.",
-		})
+func (cm *ChatCommands) noteCommand(ctx context.Context, tick int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	text, err := cm.chat.moderate(ctx, entity, args.String("text"))
+	if err != nil {
+		panic(err)
+	}
+	if text == "" {
 		return
 	}
+	_, err = cm.notes.Write(ctx, tick, entity, text)
+	if err == ErrNoteLimit {
+		cm.fail(listener, "You have too many notes. Remove one with `/notedel` first.")
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+	cm.reply(listener, "Note sent.")
+}
 
-	pos := &components.Position{}
-	name := &components.Named{}
-	if err := cm.registry.LoadComponents(ctx, entity, pos, name); err != nil {
+func (cm *ChatCommands) noteEditCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	text, err := cm.chat.moderate(ctx, entity, args.String("text"))
+	if err != nil {
 		panic(err)
 	}
+	if text == "" {
+		return
+	}
+	found, err := cm.notes.Edit(ctx, entity, text, cm.anyAuthor(ctx, entity))
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		cm.fail(listener, "There isn't any note of yours here.")
+		return
+	}
+	cm.reply(listener, "Note changed.")
+}
 
-	text := strings.Join(args, " ")
+func (cm *ChatCommands) noteDeleteCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, _ ChatArgs) {
+	found, err := cm.notes.Delete(ctx, entity, cm.anyAuthor(ctx, entity))
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		cm.fail(listener, "There isn't any note of yours here.")
+		return
+	}
+	cm.reply(listener, "Note removed.")
+}
 
-	noteEntity, err := registry.NewEntity(ctx)
+// anyAuthor returns whether the entity can change the notes of other players.
+func (cm *ChatCommands) anyAuthor(ctx context.Context, entity components.Entity) bool {
+	permission, err := cm.PermissionOf(ctx, entity)
 	if err != nil {
 		panic(err)
 	}
+	return permission >= PermissionModerator
+}
 
-	err = cm.registry.CreateComponents(ctx, noteEntity,
-		&components.Position{X: pos.X, Y: pos.Y},
-		&components.Render{Char: "N", Color: 0x649ce4ff},
-		&components.Readable{Text: fmt.Sprintf("Message from %v: %v", name.Name, text)},
-	)
+func (cm *ChatCommands) whisperCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	found, err := cm.chat.Whisper(ctx, entity, args.String("player"), args.String("text"))
 	if err != nil {
 		panic(err)
 	}
+	if !found {
+		cm.fail(listener, "There is no player called %v.", args.String("player"))
+	}
+}
 
-	listener.HandleChatMessage(&ChatMessage{
-		FromName: cm.systemSender,
-		Message:  fmt.Sprintf("Note sent."),
+func (cm *ChatCommands) emoteCommand(ctx context.Context, _ int64, entity components.Entity, _ ChatListener, args ChatArgs) {
+	if err := cm.chat.Emote(ctx, entity, args.String("text")); err != nil {
+		panic(err)
+	}
+}
+
+func (cm *ChatCommands) mailCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	if cm.mailbox == nil {
+		cm.fail(listener, "There is no mail in this server.")
+		return
+	}
+	name := &components.Named{}
+	if err := cm.registry.LoadComponents(ctx, entity, name); err != nil {
+		panic(err)
+	}
+	player := args.String("player")
+	text, err := cm.chat.moderate(ctx, entity, args.String("text"))
+	if err != nil {
+		panic(err)
+	}
+	if text == "" {
+		return
+	}
+	blocked, err := cm.mailBlocked(ctx, player, name.Name)
+	if err != nil {
+		panic(err)
+	}
+	if blocked {
+		// Like whispers, the sender isn't told.
+		cm.reply(listener, "Mail sent to %v.", player)
+		return
+	}
+	err = cm.mailbox.Send(ctx, &components.Mail{
+		From:      name.Name,
+		To:        player,
+		Text:      text,
+		Timestamp: now(),
 	})
+	switch err {
+	case nil:
+	case components.ErrUnknownMailbox:
+		cm.fail(listener, "%v has never been here.", player)
+		return
+	case components.ErrMailboxFull:
+		cm.fail(listener, "The mailbox of %v is full.", player)
+		return
+	default:
+		panic(err)
+	}
+	cm.reply(listener, "Mail sent to %v.", player)
+	cm.chat.Notify(ctx, player, fmt.Sprintf("You have new mail from %v.", name.Name))
+}
+
+// mailBlocked returns whether the character of the player called `to` blocked `from`.
+func (cm *ChatCommands) mailBlocked(ctx context.Context, to, from string) (bool, error) {
+	if cm.accounts == nil {
+		return false, nil
+	}
+	account, found, err := cm.accounts.Get(ctx, to)
+	if err != nil || !found || account.Entity == 0 {
+		return false, err
+	}
+	return cm.chat.isBlocked(ctx, components.Entity(account.Entity), from)
+}
+
+func (cm *ChatCommands) globalCommand(ctx context.Context, _ int64, entity components.Entity, _ ChatListener, args ChatArgs) {
+	if err := cm.chat.SayGlobal(ctx, entity, args.String("text")); err != nil {
+		panic(err)
+	}
+}
+
+func (cm *ChatCommands) broadcastCommand(ctx context.Context, _ int64, _ components.Entity, _ ChatListener, args ChatArgs) {
+	if err := cm.chat.Broadcast(ctx, args.String("text")); err != nil {
+		panic(err)
+	}
+}
+
+func (cm *ChatCommands) joinCommand(_ context.Context, _ int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	channel := args.String("channel")
+	if !cm.chat.JoinChannel(entity, channel) {
+		cm.fail(listener, "You can't join %v.", channel)
+		return
+	}
+	cm.reply(listener, "Joined %v. Use `/c %v` to talk in it.", channel, channel)
+}
+
+func (cm *ChatCommands) leaveCommand(_ context.Context, _ int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	channel := args.String("channel")
+	if !cm.chat.LeaveChannel(entity, channel) {
+		cm.fail(listener, "You aren't in %v.", channel)
+		return
+	}
+	cm.reply(listener, "Left %v.", channel)
+}
+
+func (cm *ChatCommands) channelCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	channel := args.String("channel")
+	member, err := cm.chat.SayChannel(ctx, entity, channel, args.String("text"))
+	if err != nil {
+		panic(err)
+	}
+	if !member {
+		cm.fail(listener, "You aren't in %v. Use `/join %v` first.", channel, channel)
+	}
+}
+
+func (cm *ChatCommands) channelsCommand(_ context.Context, _ int64, entity components.Entity, listener ChatListener, _ ChatArgs) {
+	channels := cm.chat.Channels(entity)
+	if len(channels) == 0 {
+		cm.reply(listener, "You are only in the local and global channels.")
+		return
+	}
+	cm.reply(listener, "You are in the local and global channels, and in: %v.", strings.Join(channels, ", "))
+}
+
+func (cm *ChatCommands) blockCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	player := args.String("player")
+	name := &components.Named{}
+	if err := cm.registry.LoadComponents(ctx, entity, name); err != nil {
+		panic(err)
+	}
+	if player == name.Name {
+		cm.fail(listener, "You can't block yourself.")
+		return
+	}
+
+	if err := cm.chat.Block(ctx, entity, player); err != nil {
+		panic(err)
+	}
+	cm.reply(listener, "Blocked %v.", player)
+}
+
+func (cm *ChatCommands) unblockCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, args ChatArgs) {
+	player := args.String("player")
+	found, err := cm.chat.Unblock(ctx, entity, player)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		cm.fail(listener, "%v isn't blocked.", player)
+		return
+	}
+	cm.reply(listener, "Unblocked %v.", player)
+}
+
+func (cm *ChatCommands) blockedCommand(ctx context.Context, _ int64, entity components.Entity, listener ChatListener, _ ChatArgs) {
+	names, err := cm.chat.Blocked(ctx, entity)
+	if err != nil {
+		panic(err)
+	}
+	if len(names) == 0 {
+		cm.reply(listener, "You haven't blocked anyone.")
+		return
+	}
+	cm.reply(listener, "You blocked: %v.", strings.Join(names, ", "))
 }
@@ -0,0 +1,134 @@
+package systems
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	components "github.com/code-cell/esive/components"
+)
+
+// maxMuteDuration is how long mutes can get by doubling.
+const maxMuteDuration = 24 * time.Hour
+
+type ChatModerationConfig struct {
+	// Rate is how many messages per second an entity can send in the long run, and Burst how many in a row.
+	Rate  float64
+	Burst int
+	// RepeatLimit is how many times in a row the same message can be sent. Zero disables the check.
+	RepeatLimit  int
+	BlockedWords []string
+	// Warnings is how many offenses are only warned about before muting. The first mute lasts MuteDuration, and
+	// every following one twice the previous, up to a day.
+	Warnings     int
+	MuteDuration time.Duration
+}
+
+// ChatModerator rate limits the messages of every entity, stops repeated messages and censors blocked words.
+// Offenses are warned about first, and then punished with temporary mutes.
+type ChatModerator struct {
+	config  ChatModerationConfig
+	blocked *regexp.Regexp
+	now     func() time.Time
+
+	// entities is keyed by the characters, which players keep between sessions, so reconnecting doesn't lift a mute.
+	entities map[components.Entity]*chatModerationState
+	mtx      sync.Mutex
+}
+
+type chatModerationState struct {
+	tokens     float64
+	lastRefill time.Time
+
+	lastMessage string
+	repeats     int
+
+	offenses   int
+	mutes      int
+	mutedUntil time.Time
+}
+
+func NewChatModerator(config ChatModerationConfig) *ChatModerator {
+	m := &ChatModerator{
+		config:   config,
+		now:      time.Now,
+		entities: map[components.Entity]*chatModerationState{},
+	}
+	words := []string{}
+	for _, word := range config.BlockedWords {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, regexp.QuoteMeta(word))
+		}
+	}
+	if len(words) > 0 {
+		m.blocked = regexp.MustCompile(`(?i)\b(` + strings.Join(words, "|") + `)\b`)
+	}
+	return m
+}
+
+// Moderate checks a message before it's sent. It returns the text to send, which is empty if the message has to be
+// dropped, and a notice for the sender if there is anything to tell.
+func (m *ChatModerator) Moderate(entity components.Entity, text string) (string, string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	now := m.now()
+	state, found := m.entities[entity]
+	if !found {
+		state = &chatModerationState{tokens: float64(m.config.Burst), lastRefill: now}
+		m.entities[entity] = state
+	}
+
+	if now.Before(state.mutedUntil) {
+		return "", fmt.Sprintf("You are muted for %v.", state.mutedUntil.Sub(now).Round(time.Second))
+	}
+
+	state.tokens += now.Sub(state.lastRefill).Seconds() * m.config.Rate
+	if state.tokens > float64(m.config.Burst) {
+		state.tokens = float64(m.config.Burst)
+	}
+	state.lastRefill = now
+	if state.tokens < 1 {
+		return "", m.offense(state, now, "You are sending messages too fast.")
+	}
+	state.tokens--
+
+	if text == state.lastMessage {
+		state.repeats++
+	} else {
+		state.lastMessage = text
+		state.repeats = 1
+	}
+	if m.config.RepeatLimit > 0 && state.repeats > m.config.RepeatLimit {
+		return "", m.offense(state, now, "Stop repeating the same message.")
+	}
+
+	if m.blocked != nil && m.blocked.MatchString(text) {
+		censored := m.blocked.ReplaceAllStringFunc(text, func(word string) string {
+			return strings.Repeat("*", len(word))
+		})
+		return censored, m.offense(state, now, "Mind your language.")
+	}
+	return text, ""
+}
+
+// offense has to be called with the mutex locked.
+func (m *ChatModerator) offense(state *chatModerationState, now time.Time, reason string) string {
+	state.offenses++
+	if state.offenses <= m.config.Warnings {
+		return reason + " This is a warning."
+	}
+	state.offenses = 0
+	state.mutes++
+	duration := m.config.MuteDuration
+	for i := 1; i < state.mutes && duration < maxMuteDuration; i++ {
+		duration *= 2
+	}
+	if duration > maxMuteDuration && m.config.MuteDuration <= maxMuteDuration {
+		duration = maxMuteDuration
+	}
+	state.mutedUntil = now.Add(duration)
+	return fmt.Sprintf("%v You are muted for %v.", reason, duration)
+}
@@ -0,0 +1,77 @@
+package systems
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestModerator(config ChatModerationConfig) (*ChatModerator, *time.Time) {
+	now := time.Unix(0, 0)
+	m := NewChatModerator(config)
+	m.now = func() time.Time { return now }
+	return m, &now
+}
+
+func TestChatModerator_RateLimit(t *testing.T) {
+	m, now := newTestModerator(ChatModerationConfig{Rate: 1, Burst: 2, Warnings: 1, MuteDuration: 10 * time.Second})
+
+	text, notice := m.Moderate(1, "a")
+	require.Equal(t, "a", text)
+	require.Empty(t, notice)
+	text, _ = m.Moderate(1, "b")
+	require.Equal(t, "b", text)
+
+	text, notice = m.Moderate(1, "c")
+	require.Empty(t, text)
+	require.Contains(t, notice, "warning")
+
+	text, notice = m.Moderate(1, "d")
+	require.Empty(t, text)
+	require.Contains(t, notice, "muted for 10s")
+
+	*now = now.Add(5 * time.Second)
+	text, notice = m.Moderate(1, "e")
+	require.Empty(t, text)
+	require.Contains(t, notice, "muted for 5s")
+
+	*now = now.Add(5 * time.Second)
+	text, _ = m.Moderate(1, "f")
+	require.Equal(t, "f", text)
+
+	text, _ = m.Moderate(2, "g")
+	require.Equal(t, "g", text, "other entities have their own limits")
+}
+
+func TestChatModerator_Repeats(t *testing.T) {
+	m, _ := newTestModerator(ChatModerationConfig{Rate: 1, Burst: 10, RepeatLimit: 2, Warnings: 0, MuteDuration: time.Second})
+
+	m.Moderate(1, "spam")
+	m.Moderate(1, "spam")
+	text, notice := m.Moderate(1, "spam")
+	require.Empty(t, text)
+	require.Contains(t, notice, "muted for 1s")
+}
+
+func TestChatModerator_MuteDurationIsCapped(t *testing.T) {
+	m, now := newTestModerator(ChatModerationConfig{Rate: 1, Burst: 10, RepeatLimit: 1, Warnings: 0, MuteDuration: time.Minute})
+
+	m.Moderate(1, "spam")
+	for i := 0; i < 100; i++ {
+		_, notice := m.Moderate(1, "spam")
+		require.Contains(t, notice, "You are muted for")
+		require.True(t, m.entities[1].mutedUntil.After(*now), "mute %d lifted the mute", i+1)
+		*now = m.entities[1].mutedUntil
+	}
+	_, notice := m.Moderate(1, "spam")
+	require.Contains(t, notice, "muted for 24h0m0s")
+}
+
+func TestChatModerator_BlockedWords(t *testing.T) {
+	m, _ := newTestModerator(ChatModerationConfig{Rate: 1, Burst: 10, BlockedWords: []string{"darn"}, Warnings: 5})
+
+	text, notice := m.Moderate(1, "Darn it, not darnation")
+	require.Equal(t, "**** it, not darnation", text)
+	require.Contains(t, notice, "warning")
+}
@@ -0,0 +1,284 @@
+package systems
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/code-cell/esive/actions"
+	components "github.com/code-cell/esive/components"
+	"github.com/code-cell/esive/tick"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testChatListener struct {
+	mtx      sync.Mutex
+	messages []*ChatMessage
+}
+
+func (l *testChatListener) HandleChatMessage(message *ChatMessage) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.messages = append(l.messages, message)
+}
+
+func (l *testChatListener) received() []string {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	res := []string{}
+	for _, message := range l.messages {
+		res = append(res, message.Channel+":"+message.Message)
+	}
+	return res
+}
+
+func newSpeaker(t *testing.T, env *Env, chat *ChatSystem, name string, x, y int64) (components.Entity, *testChatListener) {
+	entity, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), entity,
+		&components.Named{Name: name},
+		&components.Position{X: x, Y: y},
+		&components.Speaker{Range: 10},
+	))
+	listener := &testChatListener{}
+	require.NoError(t, chat.AddListener(entity, name, listener))
+	return entity, listener
+}
+
+func TestChat_Channels(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	alice, aliceListener := newSpeaker(t, env, chat, "alice", 0, 0)
+	bob, bobListener := newSpeaker(t, env, chat, "bob", 5, 0)
+	_, carolListener := newSpeaker(t, env, chat, "carol", 100, 0)
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "hi"))
+	require.Equal(t, []string{"local:hi"}, bobListener.received())
+	require.Empty(t, carolListener.received(), "local messages only reach the players in range")
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/g hello everyone"))
+	require.Equal(t, []string{"global:hello everyone"}, carolListener.received())
+
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "/w carol psst"))
+	require.Equal(t, []string{"global:hello everyone", "whisper:psst"}, carolListener.received())
+	require.NotContains(t, aliceListener.received(), "whisper:psst")
+
+	require.True(t, chat.JoinChannel(alice, "party"))
+	require.True(t, chat.JoinChannel(bob, "party"))
+	require.False(t, chat.JoinChannel(bob, ChannelGlobal))
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "/c party let's go"))
+	require.Contains(t, aliceListener.received(), "party:let's go")
+	require.NotContains(t, carolListener.received(), "party:let's go")
+
+	chat.RemoveListener(bob)
+	require.Equal(t, []string{"party"}, chat.Channels(alice))
+	require.Empty(t, chat.Channels(bob))
+}
+
+func TestChat_MuteSurvivesReconnect(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	chat.SetModerator(NewChatModerator(ChatModerationConfig{Rate: 1, Burst: 10, RepeatLimit: 1, MuteDuration: time.Minute}))
+	alice, aliceListener := newSpeaker(t, env, chat, "alice", 0, 0)
+	_, bobListener := newSpeaker(t, env, chat, "bob", 5, 0)
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "spam"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "spam"))
+
+	chat.RemoveListener(alice)
+	require.NoError(t, chat.AddListener(alice, "alice", aliceListener))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "I'm back"))
+	require.Equal(t, []string{"local:spam"}, bobListener.received())
+}
+
+func TestChat_CommandsAreNotModerated(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	chat.SetModerator(NewChatModerator(ChatModerationConfig{Rate: 1, Burst: 2, RepeatLimit: 1, MuteDuration: time.Minute}))
+	alice, aliceListener := newSpeaker(t, env, chat, "alice", 0, 0)
+	_, bobListener := newSpeaker(t, env, chat, "bob", 5, 0)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, chat.Say(context.Background(), 0, alice, "/blocked"))
+	}
+	for _, message := range aliceListener.messages {
+		require.NotEqual(t, ChatKindError, message.Kind, "repeating a command never mutes: %v", message.Message)
+	}
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "spam"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "spam"))
+	require.Equal(t, []string{"local:spam"}, bobListener.received())
+
+	aliceListener.messages = nil
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/block bob"))
+	require.Equal(t, []string{":Blocked bob."}, aliceListener.received(), "muted players can still run commands")
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/w bob hi"))
+	require.Contains(t, aliceListener.received()[1], "muted", "but what they send is still moderated")
+}
+
+func TestChat_History(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 3, zap.NewNop()))
+	alice, _ := newSpeaker(t, env, chat, "alice", 0, 0)
+	for _, text := range []string{"one", "two", "three", "four"} {
+		require.NoError(t, chat.Say(context.Background(), 0, alice, text))
+	}
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/g hello"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/unknown"))
+
+	bob, _ := newSpeaker(t, env, chat, "bob", 5, 0)
+	backlog, err := chat.Backlog(context.Background(), bob, 10)
+	require.NoError(t, err)
+	texts := []string{}
+	for _, message := range backlog {
+		texts = append(texts, message.Message)
+	}
+	require.Equal(t, []string{"two", "three", "four", "hello"}, texts, "only the last messages of each log are kept")
+
+	history, err := chat.History(context.Background(), alice, ChannelLocal, backlog[2].Id+1, 2)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, "four", history[1].Message)
+
+	private, err := chat.History(context.Background(), alice, ChannelWhisper, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, private, 1, "the answer to the unknown command")
+
+	_, err = chat.History(context.Background(), alice, "party", 0, 10)
+	require.Equal(t, ErrNotInChannel, err)
+}
+
+func TestChat_HistorySameTimestamp(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	alice, _ := newSpeaker(t, env, chat, "alice", 0, 0)
+	for _, text := range []string{"one", "two", "three", "four", "five"} {
+		message := &ChatMessage{Kind: ChatKindPlayer, From: alice, FromName: "alice", Message: text, Channel: ChannelGlobal, Timestamp: 1000}
+		require.NoError(t, chat.log(context.Background(), message, globalLog))
+	}
+
+	texts := []string{}
+	before := int64(0)
+	for {
+		page, err := chat.History(context.Background(), alice, ChannelGlobal, before, 2)
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		for i := len(page) - 1; i >= 0; i-- {
+			texts = append(texts, page[i].Message)
+		}
+		before = page[0].Id
+	}
+	require.Equal(t, []string{"five", "four", "three", "two", "one"}, texts, "no message is skipped or repeated")
+}
+
+func TestChat_Block(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	alice, aliceListener := newSpeaker(t, env, chat, "alice", 0, 0)
+	bob, bobListener := newSpeaker(t, env, chat, "bob", 5, 0)
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/block bob"))
+	require.Equal(t, []string{":Blocked bob."}, aliceListener.received())
+	blockList := &components.BlockList{}
+	require.NoError(t, env.registry.LoadComponents(context.Background(), alice, blockList))
+	require.Equal(t, []string{"bob"}, blockList.Names, "the block list is stored in the entity")
+
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "hi"))
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "/w alice psst"))
+	require.Equal(t, []string{":Blocked bob."}, aliceListener.received())
+	require.Equal(t, []string{"local:hi", "whisper:psst"}, bobListener.received(), "bob isn't told about the block")
+
+	backlog, err := chat.Backlog(context.Background(), alice, 10)
+	require.NoError(t, err)
+	require.Len(t, backlog, 1, "only the answer to the command")
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/unblock bob"))
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "hi again"))
+	require.Contains(t, aliceListener.received(), "local:hi again")
+}
+
+func TestChat_Kinds(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	chat.SetTick(tick.NewTick(42, time.Second))
+	alice, aliceListener := newSpeaker(t, env, chat, "alice", 0, 0)
+	_, bobListener := newSpeaker(t, env, chat, "bob", 5, 0)
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "hi"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/me waves"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/w bob psst"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/channels"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/nope"))
+
+	kinds := func(messages []*ChatMessage) []ChatKind {
+		res := []ChatKind{}
+		for _, message := range messages {
+			res = append(res, message.Kind)
+		}
+		return res
+	}
+	require.Equal(t, []ChatKind{ChatKindPlayer, ChatKindEmote, ChatKindWhisper}, kinds(bobListener.messages))
+	require.Equal(t, []ChatKind{ChatKindPlayer, ChatKindEmote, ChatKindWhisper, ChatKindSystem, ChatKindError}, kinds(aliceListener.messages))
+	for _, message := range aliceListener.messages {
+		require.Equal(t, int64(42), message.Tick)
+		require.NotZero(t, message.Timestamp)
+	}
+	require.Equal(t, alice, bobListener.messages[0].From)
+	require.Zero(t, aliceListener.messages[3].From, "system messages have no sender")
+
+	history, err := chat.History(context.Background(), alice, ChannelLocal, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []ChatKind{ChatKindPlayer, ChatKindEmote}, kinds(history))
+}
+
+func TestChat_Mail(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	mailbox := components.NewMailbox(env.store, 10, zap.NewNop())
+	chat.SetMailbox(mailbox, components.NewAccounts(env.store, zap.NewNop()))
+	alice, aliceListener := newSpeaker(t, env, chat, "alice", 0, 0)
+	_, bobListener := newSpeaker(t, env, chat, "bob", 100, 0)
+	require.NoError(t, mailbox.Open(context.Background(), "bob"))
+	require.NoError(t, mailbox.Open(context.Background(), "carol"))
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/mail bob see you"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/mail carol are you there?"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/mail dave hi"))
+	require.Equal(t, []string{":Mail sent to bob.", ":Mail sent to carol.", ":dave has never been here."}, aliceListener.received())
+	require.Equal(t, []string{":You have new mail from alice."}, bobListener.received(), "connected players are told")
+
+	mails, err := mailbox.List(context.Background(), "carol")
+	require.NoError(t, err)
+	require.Len(t, mails, 1)
+	require.Equal(t, "alice", mails[0].From)
+	require.Equal(t, "are you there?", mails[0].Text)
+}
+
+func TestChat_MailToBlocker(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	mailbox := components.NewMailbox(env.store, 10, zap.NewNop())
+	accounts := components.NewAccounts(env.store, zap.NewNop())
+	chat.SetMailbox(mailbox, accounts)
+	alice, aliceListener := newSpeaker(t, env, chat, "alice", 0, 0)
+	bob, bobListener := newSpeaker(t, env, chat, "bob", 100, 0)
+	_, err := accounts.Login(context.Background(), "bob", "secret")
+	require.NoError(t, err)
+	require.NoError(t, accounts.SetEntity(context.Background(), "bob", bob))
+	require.NoError(t, mailbox.Open(context.Background(), "bob"))
+
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "/block alice"))
+	chat.RemoveListener(bob)
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/mail bob hi"))
+	require.Equal(t, []string{":Mail sent to bob."}, aliceListener.received(), "the sender isn't told")
+	require.Equal(t, []string{":Blocked alice."}, bobListener.received())
+
+	mails, err := mailbox.List(context.Background(), "bob")
+	require.NoError(t, err)
+	require.Empty(t, mails)
+}
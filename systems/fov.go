@@ -0,0 +1,73 @@
+package systems
+
+type tile struct {
+	x int64
+	y int64
+}
+
+// Multipliers to transform coordinates from the first octant into each of the 8 octants.
+var octants = [8][4]int64{
+	{1, 0, 0, 1},
+	{0, 1, 1, 0},
+	{0, -1, 1, 0},
+	{-1, 0, 0, 1},
+	{-1, 0, 0, -1},
+	{0, -1, -1, 0},
+	{0, 1, -1, 0},
+	{1, 0, 0, -1},
+}
+
+// fieldOfView returns the tiles visible from [x, y] within `radius` using recursive shadow casting. Opaque tiles
+// are visible themselves, but hide what's behind them.
+func fieldOfView(x, y int64, radius int, opaque func(tile) bool) map[tile]struct{} {
+	visible := map[tile]struct{}{{x, y}: {}}
+	for _, o := range octants {
+		castLight(visible, x, y, int64(radius), 1, 1.0, 0.0, o, opaque)
+	}
+	return visible
+}
+
+// castLight scans one octant row by row, starting at `row`, between the slopes `start` and `end`. When it finds an
+// opaque tile it recurses for the part of the next row that is still lit, and carries on with the shadow narrowed.
+func castLight(visible map[tile]struct{}, cx, cy, radius, row int64, start, end float64, o [4]int64, opaque func(tile) bool) {
+	if start < end {
+		return
+	}
+	radiusSq := radius * radius
+	for j := row; j <= radius; j++ {
+		dx, dy := -j-1, -j
+		blocked := false
+		newStart := 0.0
+		for dx <= 0 {
+			dx++
+			t := tile{x: cx + dx*o[0] + dy*o[1], y: cy + dx*o[2] + dy*o[3]}
+			leftSlope := (float64(dx) - 0.5) / (float64(dy) + 0.5)
+			rightSlope := (float64(dx) + 0.5) / (float64(dy) - 0.5)
+			if start < rightSlope {
+				continue
+			} else if end > leftSlope {
+				break
+			}
+
+			if dx*dx+dy*dy <= radiusSq {
+				visible[t] = struct{}{}
+			}
+
+			if blocked {
+				if opaque(t) {
+					newStart = rightSlope
+					continue
+				}
+				blocked = false
+				start = newStart
+			} else if opaque(t) && j < radius {
+				blocked = true
+				castLight(visible, cx, cy, radius, j+1, start, leftSlope, o, opaque)
+				newStart = rightSlope
+			}
+		}
+		if blocked {
+			break
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package systems
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldOfView_Open(t *testing.T) {
+	fov := fieldOfView(0, 0, 5, func(tile) bool { return false })
+
+	require.Contains(t, fov, tile{0, 0})
+	require.Contains(t, fov, tile{5, 0})
+	require.Contains(t, fov, tile{-3, 4})
+	require.NotContains(t, fov, tile{4, 4})
+	require.NotContains(t, fov, tile{6, 0})
+}
+
+func TestFieldOfView_Wall(t *testing.T) {
+	walls := map[tile]struct{}{
+		{2, -1}: {},
+		{2, 0}:  {},
+		{2, 1}:  {},
+	}
+	fov := fieldOfView(0, 0, 5, func(t tile) bool {
+		_, found := walls[t]
+		return found
+	})
+
+	require.Contains(t, fov, tile{2, 0}, "walls are visible")
+	require.NotContains(t, fov, tile{3, 0})
+	require.NotContains(t, fov, tile{5, 0})
+	require.Contains(t, fov, tile{0, 5})
+	require.Contains(t, fov, tile{-5, 0})
+}
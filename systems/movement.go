@@ -71,7 +71,7 @@ func (s *MovementSystem) Teleport(parentContext context.Context, tick int64, ent
 	pos := &components.Position{}
 	err := registry.LoadComponents(ctx, entity, pos)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	newPos := &components.Position{
 		X: newX,
@@ -85,15 +85,17 @@ func (s *MovementSystem) Teleport(parentContext context.Context, tick int64, ent
 	pos.X = newX
 	pos.Y = newY
 
-	registry.UpdateComponents(ctx, entity, pos)
+	if err := registry.UpdateComponents(ctx, entity, pos); err != nil {
+		return err
+	}
 	// TODO: Do something better with the moveable.
 	err = s.visionSystem.HandleMovement(ctx, tick, entity, &components.Moveable{}, oldPos, newPos)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	geo.OnMovePosition(ctx, entity, oldPos, newPos)
 
-	return err
+	return nil
 }
 
 func (m *MovementSystem) ChunksWithMovingEntities(parentContext context.Context) (map[int64]map[int64]struct{}, error) {
@@ -8,9 +8,11 @@ import (
 	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 type Env struct {
+	store    *components.RedisStore
 	registry *components.Registry
 	geo      *components.Geo
 	movement *MovementSystem
@@ -30,11 +32,20 @@ func Setup(t *testing.T) *Env {
 	geo := components.NewGeo(registry, store, 15, logger)
 	SetRegistry(registry)
 	SetGeo(geo)
+	SetNoteIndex(components.NewNoteIndex(store, logger))
 
 	vision := NewVisionSystem(15)
 	movement := NewMovementSystem(vision)
 
+	registry.OnCreateComponent(func(ctx context.Context, entity components.Entity, component proto.Message) {
+		vision.HandleNewComponent(ctx, 0, string(component.ProtoReflect().Descriptor().FullName().Name()), entity)
+	})
+	registry.OnDeleteComponent(func(ctx context.Context, entity components.Entity, component proto.Message) {
+		vision.HandleRemovedComponent(ctx, 0, string(component.ProtoReflect().Descriptor().FullName().Name()), entity)
+	})
+
 	return &Env{
+		store:    store,
 		registry: registry,
 		geo:      geo,
 		movement: movement,
@@ -0,0 +1,9 @@
+package systems
+
+import components "github.com/code-cell/esive/components"
+
+var noteIndex *components.NoteIndex
+
+func SetNoteIndex(i *components.NoteIndex) {
+	noteIndex = i
+}
@@ -0,0 +1,204 @@
+package systems
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	components "github.com/code-cell/esive/components"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+)
+
+var notesTracer = otel.Tracer("systems/notes")
+
+var ErrNoteLimit = errors.New("Too many notes")
+
+// NoteSystem manages the notes players leave in the world. Notes belong to the name of their author, which is how
+// players find their own. They are indexed by author and expiry, so neither needs to go through every note.
+type NoteSystem struct {
+	// limit is how many notes a player can have at the same time. 0 means no limit.
+	limit int
+	// expiry is how long notes last. 0 means forever.
+	expiry time.Duration
+	now    func() time.Time
+}
+
+func NewNoteSystem(limit int, expiry time.Duration) *NoteSystem {
+	return &NoteSystem{
+		limit:  limit,
+		expiry: expiry,
+		now:    time.Now,
+	}
+}
+
+// Write leaves a note with the text where the author is. It returns ErrNoteLimit if the author has too many.
+func (s *NoteSystem) Write(parentContext context.Context, tick int64, author components.Entity, text string) (components.Entity, error) {
+	ctx, span := notesTracer.Start(parentContext, "notes.Write")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(author)),
+	)
+	defer span.End()
+
+	pos := &components.Position{}
+	name := &components.Named{}
+	if err := registry.LoadComponents(ctx, author, pos, name); err != nil {
+		return 0, err
+	}
+
+	if s.limit > 0 {
+		notes, err := s.Notes(ctx, name.Name)
+		if err != nil {
+			return 0, err
+		}
+		if len(notes) >= s.limit {
+			return 0, ErrNoteLimit
+		}
+	}
+
+	noteEntity, err := registry.NewEntity(ctx)
+	if err != nil {
+		return 0, err
+	}
+	noteComponents := []proto.Message{
+		&components.Position{X: pos.X, Y: pos.Y},
+		&components.Render{Char: "N", Color: 0x649ce4ff},
+		&components.Readable{Text: text},
+		&components.Authored{Entity: int64(author), Name: name.Name, Tick: tick},
+	}
+	expires := int64(0)
+	if s.expiry > 0 {
+		expires = s.now().Add(s.expiry).UnixNano() / int64(time.Millisecond)
+		noteComponents = append(noteComponents, &components.Expires{Timestamp: expires})
+	}
+	if err := registry.CreateComponents(ctx, noteEntity, noteComponents...); err != nil {
+		return 0, err
+	}
+	return noteEntity, noteIndex.Add(ctx, noteEntity, name.Name, expires)
+}
+
+// Notes returns the notes written by the player called `name`.
+func (s *NoteSystem) Notes(parentContext context.Context, name string) ([]components.Entity, error) {
+	ctx, span := notesTracer.Start(parentContext, "notes.Notes")
+	defer span.End()
+
+	entities, err := noteIndex.ByAuthor(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	res := []components.Entity{}
+	for _, entity := range entities {
+		authored := &components.Authored{}
+		readable := &components.Readable{}
+		if err := registry.LoadComponents(ctx, entity, authored, readable); err != nil {
+			return nil, err
+		}
+		if authored.Name != name {
+			// Deleted some other way, like with `kill` in the server REPL.
+			if err := noteIndex.Remove(ctx, entity, name); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if readable.Text != "" {
+			res = append(res, entity)
+		}
+	}
+	return res, nil
+}
+
+// Edit changes the text of the note where the entity is. Only its author can change it, unless `anyAuthor` is set.
+// It returns false if there isn't any note it can change.
+func (s *NoteSystem) Edit(parentContext context.Context, entity components.Entity, text string, anyAuthor bool) (bool, error) {
+	ctx, span := notesTracer.Start(parentContext, "notes.Edit")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	note, found, err := s.noteAt(ctx, entity, anyAuthor)
+	if err != nil || !found {
+		return false, err
+	}
+	return true, registry.UpdateComponents(ctx, note, &components.Readable{Text: text})
+}
+
+// Delete removes the note where the entity is. Only its author can remove it, unless `anyAuthor` is set. It
+// returns false if there isn't any note it can remove.
+func (s *NoteSystem) Delete(parentContext context.Context, entity components.Entity, anyAuthor bool) (bool, error) {
+	ctx, span := notesTracer.Start(parentContext, "notes.Delete")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+	)
+	defer span.End()
+
+	note, found, err := s.noteAt(ctx, entity, anyAuthor)
+	if err != nil || !found {
+		return false, err
+	}
+	return true, s.delete(ctx, note)
+}
+
+// Expire deletes the notes that have expired.
+func (s *NoteSystem) Expire(parentContext context.Context) error {
+	ctx, span := notesTracer.Start(parentContext, "notes.Expire")
+	defer span.End()
+
+	entities, err := noteIndex.Expired(ctx, s.now().UnixNano()/int64(time.Millisecond))
+	if err != nil {
+		return err
+	}
+	for _, entity := range entities {
+		if err := s.delete(ctx, entity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delete deletes a note and takes it out of the index.
+func (s *NoteSystem) delete(ctx context.Context, note components.Entity) error {
+	authored := &components.Authored{}
+	if err := registry.LoadComponents(ctx, note, authored); err != nil {
+		return err
+	}
+	if err := registry.DeleteEntity(ctx, note); err != nil {
+		return err
+	}
+	return noteIndex.Remove(ctx, note, authored.Name)
+}
+
+// noteAt finds the note where the entity is, preferring its own ones.
+func (s *NoteSystem) noteAt(ctx context.Context, entity components.Entity, anyAuthor bool) (components.Entity, bool, error) {
+	pos := &components.Position{}
+	name := &components.Named{}
+	if err := registry.LoadComponents(ctx, entity, pos, name); err != nil {
+		return 0, false, err
+	}
+
+	entities, _, extras, err := geo.FindInRange(ctx, pos.X, pos.Y, 0, &components.Authored{}, &components.Readable{})
+	if err != nil {
+		return 0, false, err
+	}
+	var other components.Entity
+	foundOther := false
+	for i, noteEntity := range entities {
+		authored := extras[i][0].(*components.Authored)
+		readable := extras[i][1].(*components.Readable)
+		if authored.Name == "" || readable.Text == "" {
+			continue
+		}
+		if authored.Name == name.Name {
+			return noteEntity, true, nil
+		}
+		if !foundOther {
+			other = noteEntity
+			foundOther = true
+		}
+	}
+	if anyAuthor && foundOther {
+		return other, true, nil
+	}
+	return 0, false, nil
+}
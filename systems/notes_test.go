@@ -0,0 +1,88 @@
+package systems
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/code-cell/esive/actions"
+	components "github.com/code-cell/esive/components"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNotes_Commands(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	notes := NewNoteSystem(2, 0)
+	chat.SetNoteSystem(notes)
+	alice, aliceListener := newSpeaker(t, env, chat, "alice", 0, 0)
+	bob, bobListener := newSpeaker(t, env, chat, "bob", 0, 0)
+
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/note first"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/note second"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/note third"))
+	require.Equal(t, []string{
+		":Note sent.",
+		":Note sent.",
+		":You have too many notes. Remove one with `/notedel` first.",
+	}, aliceListener.received())
+
+	written, err := notes.Notes(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Len(t, written, 2)
+	authored := &components.Authored{}
+	readable := &components.Readable{}
+	require.NoError(t, env.registry.LoadComponents(context.Background(), written[0], authored, readable))
+	require.Equal(t, "alice", authored.Name)
+
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "/notedel"))
+	require.Equal(t, []string{":There isn't any note of yours here."}, bobListener.received(), "only the author can remove them")
+
+	aliceListener.messages = nil
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/noteedit changed"))
+	require.Equal(t, []string{":Note changed."}, aliceListener.received())
+
+	bobListener.messages = nil
+	require.NoError(t, SetRole(context.Background(), bob, PermissionModerator))
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "/notedel"))
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "/notedel"))
+	require.NoError(t, chat.Say(context.Background(), 0, bob, "/notedel"))
+	require.Equal(t, []string{":Note removed.", ":Note removed.", ":There isn't any note of yours here."}, bobListener.received(), "moderators remove anyone's notes")
+
+	written, err = notes.Notes(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Empty(t, written)
+
+	aliceListener.messages = nil
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/note edit the map"))
+	require.NoError(t, chat.Say(context.Background(), 0, alice, "/note delete nothing"))
+	require.Equal(t, []string{":Note sent.", ":Note sent."}, aliceListener.received(), "notes can start with any word")
+	written, err = notes.Notes(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Len(t, written, 2)
+}
+
+func TestNotes_Expire(t *testing.T) {
+	env := Setup(t)
+	chat := NewChatSystem(actions.NewActionsQueue(), env.movement, env.registry, components.NewChatLog(env.store, 100, zap.NewNop()))
+	notes := NewNoteSystem(0, 10*time.Second)
+	now := time.Now()
+	notes.now = func() time.Time { return now }
+	alice, _ := newSpeaker(t, env, chat, "alice", 0, 0)
+
+	_, err := notes.Write(context.Background(), 5, alice, "soon gone")
+	require.NoError(t, err)
+
+	now = now.Add(9 * time.Second)
+	require.NoError(t, notes.Expire(context.Background()))
+	written, err := notes.Notes(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Len(t, written, 1)
+
+	now = now.Add(time.Second)
+	require.NoError(t, notes.Expire(context.Background()))
+	written, err = notes.Notes(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Empty(t, written)
+}
@@ -0,0 +1,59 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	components "github.com/code-cell/esive/components"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+)
+
+var prefabsTracer = otel.Tracer("systems/prefabs")
+
+// prefabs make the components of every kind of entity that can be spawned, besides their position.
+var prefabs = map[string]func() []proto.Message{
+	"wall": func() []proto.Message {
+		return []proto.Message{
+			&components.Render{Char: "#", Color: 0xaf8769ff},
+			&components.Opaque{},
+		}
+	},
+	"lamp": func() []proto.Message {
+		return []proto.Message{
+			&components.Render{Char: "*", Color: 0xffe066ff},
+			&components.LightSource{Radius: 6, Color: 0xffe066ff},
+		}
+	},
+}
+
+// PrefabNames returns the names of the entities that can be spawned, sorted.
+func PrefabNames() []string {
+	names := make([]string, 0, len(prefabs))
+	for name := range prefabs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Spawn creates a new entity of the prefab called `name` at [x,y].
+func Spawn(parentContext context.Context, name string, x, y int64) (components.Entity, error) {
+	ctx, span := prefabsTracer.Start(parentContext, "Spawn")
+	span.SetAttributes(
+		attribute.String("prefab", name),
+	)
+	defer span.End()
+
+	prefab, found := prefabs[name]
+	if !found {
+		return 0, fmt.Errorf("unknown prefab %v", name)
+	}
+	entity, err := registry.NewEntity(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return entity, registry.CreateComponents(ctx, entity, append([]proto.Message{&components.Position{X: x, Y: y}}, prefab()...)...)
+}
@@ -0,0 +1,23 @@
+package systems
+
+import (
+	"context"
+	"testing"
+
+	components "github.com/code-cell/esive/components"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpawn(t *testing.T) {
+	env := Setup(t)
+
+	entity, err := Spawn(context.Background(), "lamp", 3, 4)
+	require.NoError(t, err)
+	entities, _, extras, err := env.geo.FindInRange(context.Background(), 3, 4, 0, &components.LightSource{})
+	require.NoError(t, err)
+	require.Equal(t, []components.Entity{entity}, entities)
+	require.Equal(t, float32(6), extras[0][0].(*components.LightSource).Radius)
+
+	_, err = Spawn(context.Background(), "dragon", 0, 0)
+	require.EqualError(t, err, "unknown prefab dragon")
+}
@@ -0,0 +1,57 @@
+package systems
+
+import (
+	"context"
+	"fmt"
+
+	components "github.com/code-cell/esive/components"
+	"go.opentelemetry.io/otel"
+)
+
+var rolesTracer = otel.Tracer("systems/roles")
+
+var permissionNames = map[Permission]string{
+	PermissionPlayer:    "player",
+	PermissionModerator: "moderator",
+	PermissionAdmin:     "admin",
+}
+
+func (p Permission) String() string {
+	if name, found := permissionNames[p]; found {
+		return name
+	}
+	return fmt.Sprintf("permission(%d)", int(p))
+}
+
+// ParsePermission returns the permission called `name`. Eg: `moderator`.
+func ParsePermission(name string) (Permission, error) {
+	for permission, permissionName := range permissionNames {
+		if permissionName == name {
+			return permission, nil
+		}
+	}
+	return PermissionPlayer, fmt.Errorf("unknown role %v", name)
+}
+
+// PermissionOf returns the permission granted to the entity by its Role. Entities without one are players.
+func PermissionOf(parentContext context.Context, entity components.Entity) (Permission, error) {
+	ctx, span := rolesTracer.Start(parentContext, "PermissionOf")
+	defer span.End()
+
+	role := &components.Role{}
+	if err := registry.LoadComponents(ctx, entity, role); err != nil {
+		return PermissionPlayer, err
+	}
+	return Permission(role.Level), nil
+}
+
+// SetRole grants the permission to the entity.
+func SetRole(parentContext context.Context, entity components.Entity, permission Permission) error {
+	ctx, span := rolesTracer.Start(parentContext, "SetRole")
+	defer span.End()
+
+	if permission == PermissionPlayer {
+		return registry.DeleteComponent(ctx, entity, &components.Role{})
+	}
+	return registry.CreateComponents(ctx, entity, &components.Role{Level: int32(permission)})
+}
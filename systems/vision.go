@@ -5,11 +5,11 @@ import (
 	"sync"
 
 	"github.com/code-cell/esive/components"
+	"github.com/code-cell/esive/tick"
 	"github.com/go-redis/redis/v8"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
-	"golang.org/x/sync/errgroup"
-	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/proto"
 )
 
 var visionTracer = otel.Tracer("systems/vision")
@@ -22,6 +22,11 @@ type VisionSystemLookItem struct {
 	VelX  int64
 	VelY  int64
 	Color uint32
+	// Stealth is the perception level needed to see the entity.
+	Stealth int32
+	// LightRadius and LightColor are only set for light sources.
+	LightRadius float32
+	LightColor  uint32
 }
 
 type VisionSystemUpdater interface {
@@ -30,18 +35,113 @@ type VisionSystemUpdater interface {
 }
 
 type VisionSystem struct {
+	// radius is used for the lookers that don't have a radius of their own.
 	radius      int
 	updaters    map[components.Entity]VisionSystemUpdater
 	updatersMtx sync.Mutex
+
+	// lookers and subscribers are two sides of the same index: the chunks each looker is subscribed to and the
+	// lookers subscribed to each chunk.
+	lookers     map[components.Entity]*visionLooker
+	subscribers map[components.Chunk]map[components.Entity]struct{}
+	lookersMtx  sync.Mutex
+
+	// changes accumulates the entities that moved or appeared during the current tick, per chunk, and removed the
+	// ones that disappeared. They are fanned out to the lookers on Flush.
+	changes    map[components.Chunk]map[components.Entity]*VisionSystemLookItem
+	removed    map[components.Entity]struct{}
+	changesMtx sync.Mutex
+
+	// opaque indexes in memory the tiles that block sight, as every looker needs them to compute its field of view.
+	// opaqueChanged keeps the chunks where they changed during the current tick.
+	opaque         map[tile]int
+	opaqueEntities map[components.Entity]tile
+	opaqueChanged  map[components.Chunk]struct{}
+	opaqueMtx      sync.RWMutex
+
+	// At night lookers only see the tiles lit by a light source. lights indexes them in memory and lightChanged keeps
+	// the chunks where they changed during the current tick.
+	dayCycle     tick.DayCycle
+	night        bool
+	lights       map[components.Entity]*light
+	lightChanged map[components.Chunk]struct{}
+	lightsMtx    sync.RWMutex
+
+	flushMtx sync.Mutex
+}
+
+type light struct {
+	pos    *components.Position
+	radius float32
+}
+
+type visionLooker struct {
+	pos        *components.Position
+	radius     float32
+	perception int32
+	// stale is set when the looker moved or the walls around it changed, so it has to look around again.
+	stale  bool
+	chunks []components.Chunk
+	// known is the set of entities the looker has been sent and hasn't been told to forget yet.
+	known map[components.Entity]struct{}
+	// fov is the field of view from `pos`. It's computed when needed and discarded when the looker becomes stale.
+	fov map[tile]struct{}
+	// lights are the light sources that reach its field of view. They are computed together with `fov`, only at night.
+	lights []*light
 }
 
 func NewVisionSystem(radius int) *VisionSystem {
 	return &VisionSystem{
-		radius:   radius,
-		updaters: map[components.Entity]VisionSystemUpdater{},
+		radius:      radius,
+		updaters:    map[components.Entity]VisionSystemUpdater{},
+		lookers:     map[components.Entity]*visionLooker{},
+		subscribers: map[components.Chunk]map[components.Entity]struct{}{},
+		changes:     map[components.Chunk]map[components.Entity]*VisionSystemLookItem{},
+		removed:     map[components.Entity]struct{}{},
+
+		opaque:         map[tile]int{},
+		opaqueEntities: map[components.Entity]tile{},
+		opaqueChanged:  map[components.Chunk]struct{}{},
+
+		lights:       map[components.Entity]*light{},
+		lightChanged: map[components.Chunk]struct{}{},
 	}
 }
 
+// SetDayCycle makes lookers depend on light sources to see at night.
+func (s *VisionSystem) SetDayCycle(dayCycle tick.DayCycle) {
+	s.dayCycle = dayCycle
+}
+
+// Init loads the opaque entities and light sources that already exist in the world.
+func (s *VisionSystem) Init(ctx context.Context) error {
+	ctx, span := visionTracer.Start(ctx, "vision.Init")
+	defer span.End()
+
+	entities, extras, err := registry.EntitiesWithComponentType(ctx, &components.Opaque{}, &components.Position{})
+	if err != nil {
+		return err
+	}
+	for i, entity := range entities {
+		s.addOpaque(entity, extras[i][0].(*components.Position))
+	}
+	s.opaqueMtx.Lock()
+	s.opaqueChanged = map[components.Chunk]struct{}{}
+	s.opaqueMtx.Unlock()
+
+	entities, extras, err = registry.EntitiesWithComponentType(ctx, &components.LightSource{}, &components.LightSource{}, &components.Position{})
+	if err != nil {
+		return err
+	}
+	for i, entity := range entities {
+		s.addLight(entity, extras[i][1].(*components.Position), extras[i][0].(*components.LightSource))
+	}
+	s.lightsMtx.Lock()
+	s.lightChanged = map[components.Chunk]struct{}{}
+	s.lightsMtx.Unlock()
+	return nil
+}
+
 func (s *VisionSystem) AddUpdater(entity components.Entity, updater VisionSystemUpdater) error {
 	s.updatersMtx.Lock()
 	defer s.updatersMtx.Unlock()
@@ -49,6 +149,8 @@ func (s *VisionSystem) AddUpdater(entity components.Entity, updater VisionSystem
 	return nil
 }
 
+// LookAll returns everything the looker can see. It also subscribes the looker to the chunks around it and starts
+// tracking what it knows about, so from now on it only receives the changes on every Flush.
 func (s *VisionSystem) LookAll(ctx context.Context, entity components.Entity) ([]*VisionSystemLookItem, error) {
 	ctx, span := visionTracer.Start(ctx, "vision.LookAll")
 	span.SetAttributes(
@@ -58,41 +160,41 @@ func (s *VisionSystem) LookAll(ctx context.Context, entity components.Entity) ([
 
 	looker := &components.Looker{}
 	lookerPos := &components.Position{}
-	err := registry.LoadComponents(ctx, entity, looker, lookerPos)
+	perception := &components.Perception{}
+	err := registry.LoadComponents(ctx, entity, looker, lookerPos, perception)
 	if err != nil {
 		return nil, err
 	}
 
-	entitiesInRange, positions, extras, err := geo.FindInRange(ctx, lookerPos.X, lookerPos.Y, float32(s.radius), &components.Render{}, &components.Moveable{})
+	radius := s.lookerRadius(looker)
+	entitiesInRange, positions, extras, err := geo.FindInRange(ctx, lookerPos.X, lookerPos.Y, radius, visionExtras()...)
 	if err != nil {
 		return nil, err
 	}
 
+	s.subscribe(entity, lookerPos, radius)
+
+	s.lookersMtx.Lock()
+	defer s.lookersMtx.Unlock()
+	state := s.lookers[entity]
+	state.radius = radius
+	state.perception = perception.Level
+	state.known = map[components.Entity]struct{}{}
+
 	res := make([]*VisionSystemLookItem, 0)
 	for i, cmp := range entitiesInRange {
-		pos := positions[i]
-		render := extras[i][0].(*components.Render)
-		mov := extras[i][1].(*components.Moveable)
-		res = append(res, &VisionSystemLookItem{
-			X:     pos.X,
-			Y:     pos.Y,
-			VelX:  mov.VelX,
-			VelY:  mov.VelY,
-			ID:    int64(cmp),
-			Char:  render.Char,
-			Color: render.Color,
-		})
+		item := lookItemFromExtras(cmp, positions[i], extras[i])
+		if !s.canSeeItem(entity, state, item) {
+			continue
+		}
+		res = append(res, item)
+		state.known[cmp] = struct{}{}
 	}
 
 	return res, nil
 }
 
-// TODO: Too iterative.
-// LookAll process:
-// 1. Find entities in range from the old position
-// 2. Find entities in range from the new position
-// 3. Figure out which ones lost visibility (old - new) and send LostSight to both parties (if the other is a looker)
-// 4. For all new ones, send update of the new position to both parties too
+// HandleMovement records the movement of an entity. Nothing is sent until Flush is called at the end of the tick.
 func (s *VisionSystem) HandleMovement(parentContext context.Context, tick int64, entity components.Entity, mov *components.Moveable, oldPos, newPos *components.Position) error {
 	ctx, span := visionTracer.Start(parentContext, "vision.HandleMovement")
 	span.SetAttributes(
@@ -100,107 +202,226 @@ func (s *VisionSystem) HandleMovement(parentContext context.Context, tick int64,
 	)
 	defer span.End()
 
-	s.updatersMtx.Lock()
-	updater, updaterFound := s.updaters[entity]
-	s.updatersMtx.Unlock()
+	render := &components.Render{}
+	stealth := &components.Stealth{}
+	lightSource := &components.LightSource{}
+	err := registry.LoadComponents(ctx, entity, render, stealth, lightSource)
+	if err != nil && err != redis.Nil {
+		return err
+	}
 
-	errGr := &errgroup.Group{}
+	s.queueChange(entity, lookItem(entity, newPos, mov, render, stealth, lightSource), s.movementChunks(oldPos, newPos)...)
 
-	render := &components.Render{}
-	var oldEntities []components.Entity
-	var newEntities []components.Entity
-	var newEntitiesPos []*components.Position
-	var newEntitiesExtras [][]protoreflect.ProtoMessage
-
-	errGr.Go(func() error {
-		err := registry.LoadComponents(ctx, entity, render)
-		if err != nil && err == redis.Nil {
-			// The entity has no renderer, nothing to do
-			return nil
-		}
+	s.opaqueMtx.RLock()
+	_, isOpaque := s.opaqueEntities[entity]
+	s.opaqueMtx.RUnlock()
+	if isOpaque {
+		s.removeOpaque(entity)
+		s.addOpaque(entity, newPos)
+	}
+
+	s.lightsMtx.RLock()
+	l, isLight := s.lights[entity]
+	s.lightsMtx.RUnlock()
+	if isLight {
+		s.removeLight(entity)
+		s.addLight(entity, newPos, &components.LightSource{Radius: l.radius})
+	}
+
+	s.lookersMtx.Lock()
+	if looker, found := s.lookers[entity]; found {
+		looker.pos = newPos
+		looker.stale = true
+		looker.fov = nil
+	}
+	s.lookersMtx.Unlock()
+
+	return nil
+}
+
+// SetRadius changes how far a looker sees. It will look around again on the next Flush.
+func (s *VisionSystem) SetRadius(ctx context.Context, entity components.Entity, radius float32) error {
+	ctx, span := visionTracer.Start(ctx, "vision.SetRadius")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+		attribute.Float64("radius", float64(radius)),
+	)
+	defer span.End()
+
+	looker := &components.Looker{Radius: radius}
+	if err := registry.UpdateComponents(ctx, entity, looker); err != nil {
 		return err
-	})
-	errGr.Go(func() error {
-		entities, _, _, err := geo.FindInRange(ctx, oldPos.X, oldPos.Y, float32(s.radius))
-		if err != nil {
-			return err
-		}
-		oldEntities = entities
-		return nil
-	})
+	}
 
-	errGr.Go(func() error {
-		entities, entitiesPos, entitiesExtras, err := geo.FindInRange(ctx, newPos.X, newPos.Y, float32(s.radius), &components.Render{}, &components.Moveable{})
-		if err != nil {
-			return err
+	s.lookersMtx.Lock()
+	defer s.lookersMtx.Unlock()
+	if state, found := s.lookers[entity]; found {
+		state.radius = s.lookerRadius(looker)
+		state.stale = true
+		state.fov = nil
+	}
+	return nil
+}
+
+// SetStealth changes the perception level needed to see an entity. Lookers around it find out on the next Flush.
+func (s *VisionSystem) SetStealth(ctx context.Context, entity components.Entity, level int32) error {
+	ctx, span := visionTracer.Start(ctx, "vision.SetStealth")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+		attribute.Int64("level", int64(level)),
+	)
+	defer span.End()
+
+	// The component callbacks take care of queueing the change.
+	return registry.CreateComponents(ctx, entity, &components.Stealth{Level: level})
+}
+
+// SetPerception changes up to which stealth level a looker sees. It will look around again on the next Flush.
+func (s *VisionSystem) SetPerception(ctx context.Context, entity components.Entity, level int32) error {
+	ctx, span := visionTracer.Start(ctx, "vision.SetPerception")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+		attribute.Int64("level", int64(level)),
+	)
+	defer span.End()
+
+	return registry.CreateComponents(ctx, entity, &components.Perception{Level: level})
+}
+
+// Flush sends the changes of the tick. Lookers that didn't move get the changes of the chunks they're subscribed
+// to, the ones that moved or had walls changing around them look around again. In both cases, what they receive is
+// the difference with what they already know about.
+func (s *VisionSystem) Flush(parentContext context.Context, tick int64) error {
+	ctx, span := visionTracer.Start(parentContext, "vision.Flush")
+	defer span.End()
+
+	s.flushMtx.Lock()
+	defer s.flushMtx.Unlock()
+
+	s.changesMtx.Lock()
+	changes := s.changes
+	removed := s.removed
+	s.changes = map[components.Chunk]map[components.Entity]*VisionSystemLookItem{}
+	s.removed = map[components.Entity]struct{}{}
+	s.changesMtx.Unlock()
+
+	out := newVisionOutput()
+	changed := map[components.Entity]struct{}{}
+	for _, chunkChanges := range changes {
+		for entity := range chunkChanges {
+			changed[entity] = struct{}{}
 		}
-		newEntities = entities
-		newEntitiesPos = entitiesPos
-		newEntitiesExtras = entitiesExtras
-		return nil
-	})
-	if err := errGr.Wait(); err != nil {
-		return err
 	}
 
-	// Find lookers that lost visibility
-	for _, oldEntity := range oldEntities {
-		found := false
-		for _, newEntity := range newEntities {
-			if oldEntity == newEntity {
-				found = true
-				break
+	s.opaqueMtx.Lock()
+	opaqueChanged := s.opaqueChanged
+	s.opaqueChanged = map[components.Chunk]struct{}{}
+	s.opaqueMtx.Unlock()
+
+	s.lightsMtx.Lock()
+	lightChanged := s.lightChanged
+	s.lightChanged = map[components.Chunk]struct{}{}
+	s.lightsMtx.Unlock()
+
+	s.lookersMtx.Lock()
+	night := s.dayCycle.IsNight(tick)
+	if night != s.night {
+		// Everyone sees differently when the day starts or ends.
+		s.night = night
+		for _, looker := range s.lookers {
+			looker.stale = true
+			looker.fov = nil
+		}
+	}
+	if night {
+		for chunk := range lightChanged {
+			opaqueChanged[chunk] = struct{}{}
+		}
+	}
+	for chunk := range opaqueChanged {
+		for lookerEntity := range s.subscribers[chunk] {
+			s.lookers[lookerEntity].stale = true
+			s.lookers[lookerEntity].fov = nil
+		}
+	}
+	staleLookers := map[components.Entity]*visionLooker{}
+	for lookerEntity, looker := range s.lookers {
+		for entity := range removed {
+			if _, found := looker.known[entity]; found {
+				delete(looker.known, entity)
+				out.lostSight(lookerEntity, entity)
 			}
 		}
-		if !found {
-			if updaterFound {
-				updater.HandleVisibilityLostSight(oldEntity, tick)
+		if looker.stale {
+			staleLookers[lookerEntity] = &visionLooker{pos: looker.pos, radius: looker.radius, perception: looker.perception}
+			looker.stale = false
+		}
+	}
+	for chunk, chunkChanges := range changes {
+		for lookerEntity := range s.subscribers[chunk] {
+			if _, found := staleLookers[lookerEntity]; found {
+				continue
 			}
-			s.updatersMtx.Lock()
-			externalUpdater, externalUpdaterFound := s.updaters[oldEntity]
-			s.updatersMtx.Unlock()
-			if externalUpdaterFound {
-				externalUpdater.HandleVisibilityLostSight(entity, tick)
+			looker := s.lookers[lookerEntity]
+			for entity, item := range chunkChanges {
+				if s.canSeeItem(lookerEntity, looker, item) {
+					looker.known[entity] = struct{}{}
+					out.update(lookerEntity, item)
+				} else if _, found := looker.known[entity]; found {
+					delete(looker.known, entity)
+					out.lostSight(lookerEntity, entity)
+				}
 			}
 		}
 	}
+	s.lookersMtx.Unlock()
 
-	for i, newEntity := range newEntities {
-		newEntityPos := newEntitiesPos[i]
-		newEntityRender := newEntitiesExtras[i][0].(*components.Render)
-		newEntityMov := newEntitiesExtras[i][1].(*components.Moveable)
-
-		if updaterFound {
-			updater.HandleTickUpdate(&VisionSystemLookItem{
-				ID:    int64(newEntity),
-				X:     newEntityPos.X,
-				Y:     newEntityPos.Y,
-				VelX:  newEntityMov.VelX,
-				VelY:  newEntityMov.VelY,
-				Char:  newEntityRender.Char,
-				Color: newEntityRender.Color,
-			}, tick)
+	for lookerEntity, stale := range staleLookers {
+		entities, positions, extras, err := geo.FindInRange(ctx, stale.pos.X, stale.pos.Y, stale.radius, visionExtras()...)
+		if err != nil {
+			return err
 		}
+		visible := map[components.Entity]struct{}{}
 
-		s.updatersMtx.Lock()
-		externalUpdater, externalUpdaterFound := s.updaters[newEntity]
-		s.updatersMtx.Unlock()
-		if externalUpdaterFound {
-			externalUpdater.HandleTickUpdate(&VisionSystemLookItem{
-				ID:    int64(entity),
-				X:     newPos.X,
-				Y:     newPos.Y,
-				VelX:  mov.VelX,
-				VelY:  mov.VelY,
-				Char:  render.Char,
-				Color: render.Color,
-			}, tick)
+		s.lookersMtx.Lock()
+		looker, found := s.lookers[lookerEntity]
+		if !found {
+			// The looker was removed in the meantime
+			s.lookersMtx.Unlock()
+			continue
+		}
+		for i, entity := range entities {
+			if _, found := removed[entity]; found {
+				continue
+			}
+			item := lookItemFromExtras(entity, positions[i], extras[i])
+			if !s.canSeeItem(lookerEntity, looker, item) {
+				continue
+			}
+			visible[entity] = struct{}{}
+			_, known := looker.known[entity]
+			_, moved := changed[entity]
+			if !known || moved {
+				looker.known[entity] = struct{}{}
+				out.update(lookerEntity, item)
+			}
+		}
+		for entity := range looker.known {
+			if _, found := visible[entity]; !found {
+				delete(looker.known, entity)
+				out.lostSight(lookerEntity, entity)
+			}
 		}
+		s.lookersMtx.Unlock()
+
+		s.subscribe(lookerEntity, stale.pos, stale.radius)
 	}
 
+	s.send(tick, out)
 	return nil
 }
 
+// HandleNewComponent announces new entities to the lookers around them on the next Flush.
 func (s *VisionSystem) HandleNewComponent(ctx context.Context, tick int64, t string, entity components.Entity) error {
 	ctx, span := visionTracer.Start(ctx, "vision.HandleNewComponent")
 	span.SetAttributes(
@@ -209,75 +430,367 @@ func (s *VisionSystem) HandleNewComponent(ctx context.Context, tick int64, t str
 	)
 	defer span.End()
 
-	if t != "Position" {
-		return nil
+	switch t {
+	case "Opaque":
+		pos := &components.Position{}
+		if err := registry.LoadComponents(ctx, entity, pos); err != nil {
+			return err
+		}
+		s.addOpaque(entity, pos)
+	case "Perception":
+		perception := &components.Perception{}
+		if err := registry.LoadComponents(ctx, entity, perception); err != nil {
+			return err
+		}
+		s.setPerception(entity, perception.Level)
+	case "LightSource":
+		pos := &components.Position{}
+		lightSource := &components.LightSource{}
+		if err := registry.LoadComponents(ctx, entity, pos, lightSource); err != nil {
+			return err
+		}
+		s.removeLight(entity)
+		s.addLight(entity, pos, lightSource)
+		return s.queueEntity(ctx, entity)
+	case "Position", "Stealth":
+		// A new stealth level is announced as a change, so the lookers that can't see it anymore forget it.
+		return s.queueEntity(ctx, entity)
+	}
+	return nil
+}
+
+// HandleRemovedComponent makes the lookers that know about a removed entity forget it on the next Flush.
+func (s *VisionSystem) HandleRemovedComponent(ctx context.Context, tick int64, t string, entity components.Entity) error {
+	_, span := visionTracer.Start(ctx, "vision.HandleRemovedComponent")
+	span.SetAttributes(
+		attribute.Int64("entity_id", int64(entity)),
+		attribute.String("type", t),
+	)
+	defer span.End()
+
+	switch t {
+	case "Opaque":
+		s.removeOpaque(entity)
+	case "Perception":
+		s.setPerception(entity, 0)
+	case "Stealth", "LightSource":
+		if t == "LightSource" {
+			s.removeLight(entity)
+		}
+		s.changesMtx.Lock()
+		_, removed := s.removed[entity]
+		s.changesMtx.Unlock()
+		if removed {
+			return nil
+		}
+		return s.queueEntity(ctx, entity)
+	case "Looker":
+		s.unsubscribe(entity)
+		s.updatersMtx.Lock()
+		delete(s.updaters, entity)
+		s.updatersMtx.Unlock()
+	case "Position":
+		s.changesMtx.Lock()
+		for _, chunkChanges := range s.changes {
+			// Pending changes would bring the entity back.
+			delete(chunkChanges, entity)
+		}
+		s.removed[entity] = struct{}{}
+		s.changesMtx.Unlock()
 	}
+	return nil
+}
 
+// queueEntity loads the current state of an entity and queues it as a change in its chunk.
+func (s *VisionSystem) queueEntity(ctx context.Context, entity components.Entity) error {
 	pos := &components.Position{}
 	render := &components.Render{}
-	err := registry.LoadComponents(ctx, entity, pos, render)
+	mov := &components.Moveable{}
+	stealth := &components.Stealth{}
+	lightSource := &components.LightSource{}
+	err := registry.LoadComponents(ctx, entity, pos, render, mov, stealth, lightSource)
 	if err != nil {
 		return err
 	}
 
-	lookers, extras, err := registry.EntitiesWithComponentType(ctx, &components.Looker{}, &components.Position{})
-	if err != nil {
-		return err
+	chunkX, chunkY := geo.Chunk(pos.X, pos.Y)
+	s.queueChange(entity, lookItem(entity, pos, mov, render, stealth, lightSource), components.Chunk{X: chunkX, Y: chunkY})
+	return nil
+}
+
+func (s *VisionSystem) setPerception(entity components.Entity, level int32) {
+	s.lookersMtx.Lock()
+	defer s.lookersMtx.Unlock()
+	if looker, found := s.lookers[entity]; found {
+		looker.perception = level
+		looker.stale = true
 	}
-	for i, lookerE := range lookers {
-		if lookerE == entity {
-			continue
+}
+
+func (s *VisionSystem) queueChange(entity components.Entity, item *VisionSystemLookItem, chunks ...components.Chunk) {
+	s.changesMtx.Lock()
+	defer s.changesMtx.Unlock()
+	for _, chunk := range chunks {
+		chunkChanges, found := s.changes[chunk]
+		if !found {
+			chunkChanges = map[components.Entity]*VisionSystemLookItem{}
+			s.changes[chunk] = chunkChanges
 		}
-		lookerPos := extras[i][0].(*components.Position)
+		chunkChanges[entity] = item
+	}
+}
 
-		dist := pos.Distance(lookerPos)
+func (s *VisionSystem) subscribe(entity components.Entity, pos *components.Position, radius float32) {
+	chunks := geo.ChunksInRange(pos.X, pos.Y, radius)
 
-		s.updatersMtx.Lock()
-		updater, found := s.updaters[lookerE]
-		s.updatersMtx.Unlock()
+	s.lookersMtx.Lock()
+	defer s.lookersMtx.Unlock()
+
+	looker, found := s.lookers[entity]
+	if !found {
+		looker = &visionLooker{radius: radius, known: map[components.Entity]struct{}{}}
+		s.lookers[entity] = looker
+	}
+	s.removeSubscriptions(entity, looker)
+	looker.pos = pos
+	looker.chunks = chunks
+	for _, chunk := range chunks {
+		chunkSubscribers, found := s.subscribers[chunk]
 		if !found {
-			continue
+			chunkSubscribers = map[components.Entity]struct{}{}
+			s.subscribers[chunk] = chunkSubscribers
 		}
+		chunkSubscribers[entity] = struct{}{}
+	}
+}
+
+func (s *VisionSystem) unsubscribe(entity components.Entity) {
+	s.lookersMtx.Lock()
+	defer s.lookersMtx.Unlock()
+
+	looker, found := s.lookers[entity]
+	if !found {
+		return
+	}
+	s.removeSubscriptions(entity, looker)
+	delete(s.lookers, entity)
+}
 
-		if dist <= float32(s.radius) {
-			updater.HandleTickUpdate(&VisionSystemLookItem{
-				ID:    int64(entity),
-				X:     pos.X,
-				Y:     pos.Y,
-				Char:  render.Char,
-				Color: render.Color,
-			}, tick)
+// removeSubscriptions has to be called with the lookers mutex locked.
+func (s *VisionSystem) removeSubscriptions(entity components.Entity, looker *visionLooker) {
+	for _, chunk := range looker.chunks {
+		delete(s.subscribers[chunk], entity)
+		if len(s.subscribers[chunk]) == 0 {
+			delete(s.subscribers, chunk)
 		}
 	}
-	return nil
 }
 
-func (s *VisionSystem) HandleRemovedComponent(ctx context.Context, tick int64, t string, entity components.Entity) error {
-	ctx, span := visionTracer.Start(ctx, "vision.HandleRemovedComponent")
-	span.SetAttributes(
-		attribute.Int64("entity_id", int64(entity)),
-		attribute.String("type", t),
-	)
-	defer span.End()
+// movementChunks returns the chunks where a movement has to be announced: the destination and, if it's a different
+// one, the origin so its subscribers know the entity left.
+func (s *VisionSystem) movementChunks(oldPos, newPos *components.Position) []components.Chunk {
+	oldChunkX, oldChunkY := geo.Chunk(oldPos.X, oldPos.Y)
+	newChunkX, newChunkY := geo.Chunk(newPos.X, newPos.Y)
+	newChunk := components.Chunk{X: newChunkX, Y: newChunkY}
+	if oldChunkX == newChunkX && oldChunkY == newChunkY {
+		return []components.Chunk{newChunk}
+	}
+	return []components.Chunk{newChunk, {X: oldChunkX, Y: oldChunkY}}
+}
 
-	if t != "Position" {
-		return nil
+func (s *VisionSystem) lookerRadius(looker *components.Looker) float32 {
+	if looker.Radius > 0 {
+		return looker.Radius
 	}
+	return float32(s.radius)
+}
 
-	// TODO: This broadcasts the lost component to all lookers in the game. Maybe we should do it just for the ones in sight, but the component is deleted already.
-	lookerEntities, _, err := registry.EntitiesWithComponentType(ctx, &components.Looker{})
-	if err != nil {
-		return err
+// canSeeItem checks both that the item is in the field of view and that the looker perceives it. Lookers always see
+// themselves. It has to be called with the lookers mutex locked.
+func (s *VisionSystem) canSeeItem(lookerEntity components.Entity, looker *visionLooker, item *VisionSystemLookItem) bool {
+	if item.ID == int64(lookerEntity) {
+		return true
 	}
+	if item.Stealth > looker.perception {
+		return false
+	}
+	return s.canSee(looker, &components.Position{X: item.X, Y: item.Y})
+}
 
-	for _, lookerEntity := range lookerEntities {
+// canSee has to be called with the lookers mutex locked.
+func (s *VisionSystem) canSee(looker *visionLooker, pos *components.Position) bool {
+	if looker.pos.Distance(pos) > looker.radius {
+		return false
+	}
+	if looker.fov == nil {
+		s.opaqueMtx.RLock()
+		looker.fov = fieldOfView(looker.pos.X, looker.pos.Y, int(looker.radius), func(t tile) bool {
+			return s.opaque[t] > 0
+		})
+		s.opaqueMtx.RUnlock()
+		looker.lights = nil
+		if s.night {
+			looker.lights = s.lightsAround(looker.pos, looker.radius)
+		}
+	}
+	if _, visible := looker.fov[tile{x: pos.X, y: pos.Y}]; !visible {
+		return false
+	}
+	if !s.night {
+		return true
+	}
+	for _, l := range looker.lights {
+		if l.pos.Distance(pos) <= l.radius {
+			return true
+		}
+	}
+	return false
+}
+
+// lightsAround returns the light sources that light any tile within `radius` of `pos`.
+func (s *VisionSystem) lightsAround(pos *components.Position, radius float32) []*light {
+	s.lightsMtx.RLock()
+	defer s.lightsMtx.RUnlock()
+	res := []*light{}
+	for _, l := range s.lights {
+		if l.pos.Distance(pos) <= radius+l.radius {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+func (s *VisionSystem) addLight(entity components.Entity, pos *components.Position, lightSource *components.LightSource) {
+	s.lightsMtx.Lock()
+	defer s.lightsMtx.Unlock()
+	l := &light{pos: pos, radius: lightSource.Radius}
+	s.lights[entity] = l
+	for _, chunk := range geo.ChunksInRange(pos.X, pos.Y, l.radius) {
+		s.lightChanged[chunk] = struct{}{}
+	}
+}
+
+func (s *VisionSystem) removeLight(entity components.Entity) {
+	s.lightsMtx.Lock()
+	defer s.lightsMtx.Unlock()
+	l, found := s.lights[entity]
+	if !found {
+		return
+	}
+	delete(s.lights, entity)
+	for _, chunk := range geo.ChunksInRange(l.pos.X, l.pos.Y, l.radius) {
+		s.lightChanged[chunk] = struct{}{}
+	}
+}
+
+func (s *VisionSystem) addOpaque(entity components.Entity, pos *components.Position) {
+	s.opaqueMtx.Lock()
+	defer s.opaqueMtx.Unlock()
+	t := tile{x: pos.X, y: pos.Y}
+	s.opaque[t]++
+	s.opaqueEntities[entity] = t
+	chunkX, chunkY := geo.Chunk(t.x, t.y)
+	s.opaqueChanged[components.Chunk{X: chunkX, Y: chunkY}] = struct{}{}
+}
+
+func (s *VisionSystem) removeOpaque(entity components.Entity) {
+	s.opaqueMtx.Lock()
+	defer s.opaqueMtx.Unlock()
+	t, found := s.opaqueEntities[entity]
+	if !found {
+		return
+	}
+	s.opaque[t]--
+	if s.opaque[t] <= 0 {
+		delete(s.opaque, t)
+	}
+	delete(s.opaqueEntities, entity)
+	chunkX, chunkY := geo.Chunk(t.x, t.y)
+	s.opaqueChanged[components.Chunk{X: chunkX, Y: chunkY}] = struct{}{}
+}
+
+func (s *VisionSystem) send(tick int64, out *visionOutput) {
+	for lookerEntity, items := range out.updates {
 		s.updatersMtx.Lock()
 		updater, found := s.updaters[lookerEntity]
 		s.updatersMtx.Unlock()
-		if found {
+		if !found {
+			continue
+		}
+		for _, item := range items {
+			updater.HandleTickUpdate(item, tick)
+		}
+	}
+	for lookerEntity, entities := range out.lost {
+		s.updatersMtx.Lock()
+		updater, found := s.updaters[lookerEntity]
+		s.updatersMtx.Unlock()
+		if !found {
+			continue
+		}
+		for entity := range entities {
 			updater.HandleVisibilityLostSight(entity, tick)
 		}
 	}
+}
 
-	return nil
+// visionOutput collects what has to be sent to each looker, so an entity reached through more than one chunk is
+// only sent once.
+type visionOutput struct {
+	updates map[components.Entity]map[components.Entity]*VisionSystemLookItem
+	lost    map[components.Entity]map[components.Entity]struct{}
+}
+
+func newVisionOutput() *visionOutput {
+	return &visionOutput{
+		updates: map[components.Entity]map[components.Entity]*VisionSystemLookItem{},
+		lost:    map[components.Entity]map[components.Entity]struct{}{},
+	}
+}
+
+func (o *visionOutput) update(looker components.Entity, item *VisionSystemLookItem) {
+	updates, found := o.updates[looker]
+	if !found {
+		updates = map[components.Entity]*VisionSystemLookItem{}
+		o.updates[looker] = updates
+	}
+	updates[components.Entity(item.ID)] = item
+}
+
+func (o *visionOutput) lostSight(looker, entity components.Entity) {
+	lost, found := o.lost[looker]
+	if !found {
+		lost = map[components.Entity]struct{}{}
+		o.lost[looker] = lost
+	}
+	lost[entity] = struct{}{}
+}
+
+// visionExtras are the components loaded, besides the position, to build a look item.
+func visionExtras() []proto.Message {
+	return []proto.Message{&components.Render{}, &components.Moveable{}, &components.Stealth{}, &components.LightSource{}}
+}
+
+func lookItemFromExtras(entity components.Entity, pos *components.Position, extras []proto.Message) *VisionSystemLookItem {
+	return lookItem(entity, pos,
+		extras[1].(*components.Moveable),
+		extras[0].(*components.Render),
+		extras[2].(*components.Stealth),
+		extras[3].(*components.LightSource),
+	)
+}
+
+func lookItem(entity components.Entity, pos *components.Position, mov *components.Moveable, render *components.Render, stealth *components.Stealth, lightSource *components.LightSource) *VisionSystemLookItem {
+	return &VisionSystemLookItem{
+		ID:          int64(entity),
+		X:           pos.X,
+		Y:           pos.Y,
+		VelX:        mov.VelX,
+		VelY:        mov.VelY,
+		Char:        render.Char,
+		Color:       render.Color,
+		Stealth:     stealth.Level,
+		LightRadius: lightSource.Radius,
+		LightColor:  lightSource.Color,
+	}
 }
@@ -0,0 +1,292 @@
+package systems
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	components "github.com/code-cell/esive/components"
+	"github.com/code-cell/esive/tick"
+	"github.com/stretchr/testify/require"
+)
+
+type testUpdater struct {
+	mtx       sync.Mutex
+	updates   []*VisionSystemLookItem
+	lostSight []components.Entity
+}
+
+func (u *testUpdater) HandleVisibilityLostSight(entity components.Entity, _ int64) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+	u.lostSight = append(u.lostSight, entity)
+}
+
+func (u *testUpdater) HandleTickUpdate(item *VisionSystemLookItem, _ int64) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+	u.updates = append(u.updates, item)
+}
+
+func (u *testUpdater) reset() {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+	u.updates = nil
+	u.lostSight = nil
+}
+
+func (u *testUpdater) updatedIDs() []int64 {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+	ids := []int64{}
+	for _, item := range u.updates {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func newLooker(t *testing.T, env *Env, x, y int64) (components.Entity, *testUpdater) {
+	entity, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), entity,
+		&components.Position{X: x, Y: y},
+		&components.Moveable{},
+		&components.Looker{},
+	))
+	updater := &testUpdater{}
+	require.NoError(t, env.vision.AddUpdater(entity, updater))
+	_, err = env.vision.LookAll(context.Background(), entity)
+	require.NoError(t, err)
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+	updater.reset()
+	return entity, updater
+}
+
+func newMovingEntity(t *testing.T, env *Env, x, y, velX, velY int64) components.Entity {
+	entity, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), entity,
+		&components.Position{X: x, Y: y},
+		&components.Moveable{VelX: velX, VelY: velY},
+		&components.Render{Char: "E"},
+	))
+	return entity
+}
+
+func TestVision_MovementInRange(t *testing.T) {
+	env := Setup(t)
+	_, updater := newLooker(t, env, 0, 0)
+	entity := newMovingEntity(t, env, 5, 0, 1, 0)
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+	updater.reset()
+
+	move(t, env.movement)
+	require.Empty(t, updater.updatedIDs(), "nothing is sent before the flush")
+
+	require.NoError(t, env.vision.Flush(context.Background(), 1))
+	require.Equal(t, []int64{int64(entity)}, updater.updatedIDs())
+	require.Equal(t, int64(6), updater.updates[0].X)
+	require.Empty(t, updater.lostSight)
+}
+
+func TestVision_MovementOutOfRange(t *testing.T) {
+	env := Setup(t)
+	_, updater := newLooker(t, env, 0, 0)
+	entity := newMovingEntity(t, env, 15, 0, 1, 0)
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+	updater.reset()
+
+	move(t, env.movement)
+	require.NoError(t, env.vision.Flush(context.Background(), 1))
+	require.Empty(t, updater.updates)
+	require.Equal(t, []components.Entity{entity}, updater.lostSight)
+}
+
+func TestVision_MovementInSubscribedChunkOnly(t *testing.T) {
+	env := Setup(t)
+	_, updater := newLooker(t, env, 0, 0)
+	newMovingEntity(t, env, 100, 100, 1, 0)
+
+	move(t, env.movement)
+	require.NoError(t, env.vision.Flush(context.Background(), 1))
+	require.Empty(t, updater.updates)
+	require.Empty(t, updater.lostSight)
+}
+
+func TestVision_LookerMoves(t *testing.T) {
+	env := Setup(t)
+	looker, updater := newLooker(t, env, 0, 0)
+
+	entityAhead, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), entityAhead, &components.Position{X: 16, Y: 0}))
+	entityBehind, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), entityBehind, &components.Position{X: -15, Y: 0}))
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+	require.ElementsMatch(t, []int64{int64(entityBehind)}, updater.updatedIDs())
+	updater.reset()
+
+	require.NoError(t, env.movement.SetVelocity(context.Background(), 0, looker, 1, 0))
+	move(t, env.movement)
+	require.NoError(t, env.vision.Flush(context.Background(), 1))
+
+	require.ElementsMatch(t, []int64{int64(looker), int64(entityAhead)}, updater.updatedIDs())
+	require.Equal(t, []components.Entity{entityBehind}, updater.lostSight)
+
+	// Nothing changed in the following tick
+	require.NoError(t, env.movement.SetVelocity(context.Background(), 1, looker, 0, 0))
+	require.NoError(t, env.vision.Flush(context.Background(), 2))
+	updater.reset()
+	require.NoError(t, env.vision.Flush(context.Background(), 3))
+	require.Empty(t, updater.updates)
+	require.Empty(t, updater.lostSight)
+}
+
+func TestVision_RemovedEntity(t *testing.T) {
+	env := Setup(t)
+	_, nearUpdater := newLooker(t, env, 0, 0)
+	_, farUpdater := newLooker(t, env, 100, 0)
+	entity := newMovingEntity(t, env, 5, 0, 0, 0)
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+
+	require.NoError(t, env.registry.DeleteEntity(context.Background(), entity))
+	require.NoError(t, env.vision.Flush(context.Background(), 1))
+
+	require.Equal(t, []components.Entity{entity}, nearUpdater.lostSight)
+	require.Empty(t, farUpdater.lostSight, "only the lookers that saw the entity are told")
+}
+
+func TestVision_HiddenBehindWall(t *testing.T) {
+	env := Setup(t)
+	walls := []components.Entity{}
+	for y := int64(-1); y <= 1; y++ {
+		wall, err := env.registry.NewEntity(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, env.registry.CreateComponents(context.Background(), wall,
+			&components.Position{X: 2, Y: y},
+			&components.Render{Char: "#"},
+			&components.Opaque{},
+		))
+		walls = append(walls, wall)
+	}
+	hidden := newMovingEntity(t, env, 4, 0, 0, 0)
+	visible := newMovingEntity(t, env, -4, 0, 0, 0)
+
+	looker, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), looker,
+		&components.Position{X: 0, Y: 0},
+		&components.Looker{},
+	))
+	items, err := env.vision.LookAll(context.Background(), looker)
+	require.NoError(t, err)
+
+	ids := []int64{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	require.Contains(t, ids, int64(visible))
+	require.NotContains(t, ids, int64(hidden))
+	require.Len(t, ids, 5, "3 walls, the visible entity and the looker itself")
+
+	updater := &testUpdater{}
+	require.NoError(t, env.vision.AddUpdater(looker, updater))
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+	updater.reset()
+
+	require.NoError(t, env.registry.DeleteEntity(context.Background(), walls[1]))
+	require.NoError(t, env.vision.Flush(context.Background(), 1))
+	require.Equal(t, []int64{int64(hidden)}, updater.updatedIDs(), "the entity shows up once the wall is gone")
+	require.Equal(t, []components.Entity{walls[1]}, updater.lostSight)
+}
+
+func TestVision_LookerRadius(t *testing.T) {
+	env := Setup(t)
+	near := newMovingEntity(t, env, 3, 0, 0, 0)
+	far := newMovingEntity(t, env, 5, 0, 0, 0)
+
+	looker, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), looker,
+		&components.Position{X: 0, Y: 0},
+		&components.Looker{Radius: 4},
+	))
+	updater := &testUpdater{}
+	require.NoError(t, env.vision.AddUpdater(looker, updater))
+	items, err := env.vision.LookAll(context.Background(), looker)
+	require.NoError(t, err)
+	ids := []int64{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	require.ElementsMatch(t, []int64{int64(looker), int64(near)}, ids)
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+	updater.reset()
+
+	require.NoError(t, env.vision.SetRadius(context.Background(), looker, 2))
+	require.NoError(t, env.vision.Flush(context.Background(), 1))
+	require.Equal(t, []components.Entity{near}, updater.lostSight)
+
+	updater.reset()
+	require.NoError(t, env.vision.SetRadius(context.Background(), looker, 6))
+	require.NoError(t, env.vision.Flush(context.Background(), 2))
+	require.ElementsMatch(t, []int64{int64(near), int64(far)}, updater.updatedIDs())
+}
+
+func TestVision_Stealth(t *testing.T) {
+	env := Setup(t)
+	_, updater := newLooker(t, env, 0, 0)
+	perceptive, perceptiveUpdater := newLooker(t, env, 1, 0)
+	require.NoError(t, env.vision.SetPerception(context.Background(), perceptive, 2))
+	entity := newMovingEntity(t, env, 5, 0, 0, 0)
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+	updater.reset()
+	perceptiveUpdater.reset()
+
+	require.NoError(t, env.vision.SetStealth(context.Background(), entity, 2))
+	require.NoError(t, env.vision.Flush(context.Background(), 1))
+	require.Equal(t, []components.Entity{entity}, updater.lostSight)
+	require.Equal(t, []int64{int64(entity)}, perceptiveUpdater.updatedIDs())
+	require.Empty(t, perceptiveUpdater.lostSight)
+
+	updater.reset()
+	require.NoError(t, env.registry.DeleteComponent(context.Background(), entity, &components.Stealth{}))
+	require.NoError(t, env.vision.Flush(context.Background(), 2))
+	require.Equal(t, []int64{int64(entity)}, updater.updatedIDs(), "the entity shows up again")
+}
+
+func TestVision_Night(t *testing.T) {
+	env := Setup(t)
+	env.vision.SetDayCycle(tick.DayCycle{Length: 10})
+	looker, updater := newLooker(t, env, 0, 0)
+	dark := newMovingEntity(t, env, 5, 0, 0, 0)
+	lit := newMovingEntity(t, env, -5, 0, 0, 0)
+	lamp, err := env.registry.NewEntity(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, env.registry.CreateComponents(context.Background(), lamp,
+		&components.Position{X: -6, Y: 0},
+		&components.Render{Char: "*"},
+		&components.LightSource{Radius: 2},
+	))
+	require.NoError(t, env.vision.Flush(context.Background(), 0))
+	require.ElementsMatch(t, []int64{int64(dark), int64(lit), int64(lamp)}, updater.updatedIDs())
+	updater.reset()
+
+	require.NoError(t, env.vision.Flush(context.Background(), 5))
+	require.Equal(t, []components.Entity{dark}, updater.lostSight, "only the lit tiles are visible at night")
+
+	updater.reset()
+	require.NoError(t, env.registry.CreateComponents(context.Background(), looker, &components.LightSource{Radius: 6}))
+	require.NoError(t, env.vision.Flush(context.Background(), 6))
+	require.Contains(t, updater.updatedIDs(), int64(dark), "the looker's own light reaches it")
+
+	updater.reset()
+	require.NoError(t, env.registry.DeleteComponent(context.Background(), looker, &components.LightSource{}))
+	require.NoError(t, env.vision.Flush(context.Background(), 7))
+	require.Equal(t, []components.Entity{dark}, updater.lostSight)
+
+	updater.reset()
+	require.NoError(t, env.vision.Flush(context.Background(), 10))
+	require.Equal(t, []int64{int64(dark)}, updater.updatedIDs(), "everything is visible again in the morning")
+}
@@ -0,0 +1,18 @@
+package tick
+
+// DayCycle splits time in days of `Length` ticks. The first half of every day is daylight and the second half is the
+// night. A zero length means it's always day.
+type DayCycle struct {
+	Length int64
+}
+
+func (d DayCycle) IsNight(tick int64) bool {
+	if d.Length <= 0 {
+		return false
+	}
+	t := tick % d.Length
+	if t < 0 {
+		t += d.Length
+	}
+	return t >= d.Length/2
+}
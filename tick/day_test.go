@@ -0,0 +1,18 @@
+package tick
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDayCycle(t *testing.T) {
+	day := DayCycle{Length: 10}
+	require.False(t, day.IsNight(0))
+	require.False(t, day.IsNight(4))
+	require.True(t, day.IsNight(5))
+	require.True(t, day.IsNight(9))
+	require.False(t, day.IsNight(10))
+
+	require.False(t, DayCycle{}.IsNight(7), "a zero length means it's always day")
+}
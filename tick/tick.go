@@ -8,15 +8,20 @@ import (
 )
 
 type Tick struct {
-	Delay time.Duration
+	delay int64
 
 	current    int64
 	adjustment int32
+	paused     int32
+	// steps is how many ticks are left to run while paused.
+	steps int32
 
-	ticker *time.Ticker
-	part   int8
-	parts  int8
-	done   chan struct{}
+	ticker  *time.Ticker
+	part    int8
+	parts   int8
+	done     chan struct{}
+	stopOnce sync.Once
+	delayCh  chan struct{}
 
 	subscribersMtx sync.Mutex
 	subscribers    []func(context.Context, int64)
@@ -24,10 +29,11 @@ type Tick struct {
 
 func NewTick(current int64, delay time.Duration) *Tick {
 	return &Tick{
-		Delay:       delay,
+		delay:       int64(delay),
 		current:     current,
 		parts:       4,
 		done:        make(chan struct{}),
+		delayCh:     make(chan struct{}, 1),
 		subscribers: make([]func(context.Context, int64), 0),
 	}
 }
@@ -36,6 +42,40 @@ func (tick *Tick) Current() int64 {
 	return atomic.LoadInt64(&tick.current)
 }
 
+// Delay returns how long every tick lasts.
+func (tick *Tick) Delay() time.Duration {
+	return time.Duration(atomic.LoadInt64(&tick.delay))
+}
+
+// SetDelay changes how long every tick lasts, from the next tick on.
+func (tick *Tick) SetDelay(delay time.Duration) {
+	atomic.StoreInt64(&tick.delay, int64(delay))
+	select {
+	case tick.delayCh <- struct{}{}:
+	default:
+	}
+}
+
+// Pause stops ticking until Resume is called. The tick in progress isn't interrupted.
+func (tick *Tick) Pause() {
+	atomic.StoreInt32(&tick.paused, 1)
+}
+
+func (tick *Tick) Resume() {
+	atomic.StoreInt32(&tick.steps, 0)
+	atomic.StoreInt32(&tick.paused, 0)
+}
+
+// Step runs `n` more ticks, at the usual pace, and pauses again.
+func (tick *Tick) Step(n int) {
+	atomic.StoreInt32(&tick.steps, int32(n))
+	atomic.StoreInt32(&tick.paused, 1)
+}
+
+func (tick *Tick) Paused() bool {
+	return atomic.LoadInt32(&tick.paused) == 1
+}
+
 // AdjustOnce Speeds up or slows down (by 1/4th of the tick) the tick for one single click towards the desired newVal
 func (tick *Tick) AdjustOnce(newVal int64) {
 	current := tick.Current()
@@ -50,16 +90,30 @@ func (tick *Tick) AdjustOnce(newVal int64) {
 }
 
 func (tick *Tick) Start() {
-	tick.ticker = time.NewTicker(tick.Delay / time.Duration(tick.parts))
+	tick.ticker = time.NewTicker(tick.Delay() / time.Duration(tick.parts))
+	defer tick.ticker.Stop()
 	for {
 		select {
 		case <-tick.done:
 			return
+		case <-tick.delayCh:
+			tick.ticker.Reset(tick.Delay() / time.Duration(tick.parts))
 		case <-tick.ticker.C:
+			select {
+			case <-tick.done:
+				return
+			default:
+			}
+			if tick.Paused() && atomic.LoadInt32(&tick.steps) <= 0 {
+				continue
+			}
 			tick.part += 1 + int8(atomic.LoadInt32(&tick.adjustment))
 			atomic.StoreInt32(&tick.adjustment, 0)
 			if tick.part >= tick.parts {
 				tick.part -= tick.parts
+				if tick.Paused() {
+					atomic.AddInt32(&tick.steps, -1)
+				}
 				tick.tickOnce()
 			}
 		}
@@ -69,7 +123,7 @@ func (tick *Tick) Start() {
 func (tick *Tick) tickOnce() {
 	next := atomic.AddInt64(&tick.current, 1)
 	now := time.Now()
-	deadline := now.Add(tick.Delay)
+	deadline := now.Add(tick.Delay())
 
 	tick.subscribersMtx.Lock()
 	for _, sub := range tick.subscribers {
@@ -83,9 +137,11 @@ func (tick *Tick) tickOnce() {
 	tick.subscribersMtx.Unlock()
 }
 
+// Stop stops ticking. It can be called more than once, and before Start, which then returns right away.
 func (tick *Tick) Stop() {
-	tick.ticker.Stop()
-	tick.done <- struct{}{}
+	tick.stopOnce.Do(func() {
+		close(tick.done)
+	})
 }
 
 func (tick *Tick) AddSubscriber(fn func(context.Context, int64)) {
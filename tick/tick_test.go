@@ -20,6 +20,49 @@ func TestTick(t *testing.T) {
 	require.Equal(t, int64(2), tick.Current())
 }
 
+func TestTick_Pause(t *testing.T) {
+	tick := NewTick(0, 100*time.Millisecond)
+
+	go tick.Start()
+	time.Sleep(101 * time.Millisecond) // 1 tick
+	tick.Pause()
+	require.True(t, tick.Paused())
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, int64(1), tick.Current())
+
+	tick.Resume()
+	time.Sleep(100 * time.Millisecond)
+	tick.Stop()
+	require.Equal(t, int64(2), tick.Current())
+}
+
+func TestTick_Step(t *testing.T) {
+	tick := NewTick(0, 50*time.Millisecond)
+	tick.Pause()
+
+	go tick.Start()
+	time.Sleep(101 * time.Millisecond)
+	require.Equal(t, int64(0), tick.Current())
+
+	tick.Step(2)
+	time.Sleep(201 * time.Millisecond)
+	tick.Stop()
+	require.Equal(t, int64(2), tick.Current())
+	require.True(t, tick.Paused())
+}
+
+func TestTick_SetDelay(t *testing.T) {
+	tick := NewTick(0, 100*time.Millisecond)
+
+	go tick.Start()
+	tick.SetDelay(50 * time.Millisecond)
+	time.Sleep(201 * time.Millisecond) // 4 ticks
+	tick.Stop()
+
+	require.Equal(t, 50*time.Millisecond, tick.Delay())
+	require.Equal(t, int64(4), tick.Current())
+}
+
 func TestTick_Adjust_SlowDown(t *testing.T) {
 	tick := NewTick(0, 100*time.Millisecond)
 	require.Equal(t, int64(0), tick.Current())
@@ -97,3 +140,21 @@ func TestTickSubscribers_TakeTooLong_CallsAllTicksAnyway(t *testing.T) {
 	tick.Stop()
 	require.Equal(t, int32(2), called)
 }
+
+func TestTick_Stop(t *testing.T) {
+	tick := NewTick(0, 100*time.Millisecond)
+	tick.Stop()
+	tick.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		tick.Start()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Start didn't return after Stop")
+	}
+	require.Equal(t, int64(0), tick.Current())
+}